@@ -0,0 +1,666 @@
+// Package config loads the server configuration. Settings are taken, from
+// lowest to highest precedence, from the built-in defaults, a YAML or TOML
+// configuration file, TCTXTO_* environment variables and command-line flags.
+package config
+
+import (
+	"crypto/tls"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"txtcto/server2"
+
+	"github.com/BurntSushi/toml"
+	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
+)
+
+type Config struct {
+	ServerPort          int
+	HTTPPort            int
+	AdminPort           int
+	AdminKey            string
+	WebSocketPort       int
+	WebSocketOrigins    []string
+	LogLevel            slog.Level
+	EnableReflection    bool
+	Consumers           string
+	AnnouncementsFile   string
+	TracingExporter     string
+	PingInterval        time.Duration
+	LobbySearchLimit    int
+	RematchWindow       time.Duration
+	RematchSwapSides    bool
+	DisplayNameDenyList []string
+	UniqueDisplayNames  bool
+	DisplayNameCooldown time.Duration
+	GuestTTL            time.Duration
+	ClientRateLimit     RateLimit
+	ConsumerRateLimit   RateLimit
+	JanitorInterval     time.Duration
+	IdleClientTTL       time.Duration
+	ShutdownDrainDelay  time.Duration
+	TLSCertFile         string
+	TLSKeyFile          string
+	TLSClientCAFile     string
+	TLSClientAuth       string
+	TLSReloadInterval   time.Duration
+
+	// PrintConfig asks for the effective configuration to be printed instead
+	// of starting the server.
+	PrintConfig bool
+
+	// Warnings lists deprecated settings that were used. They are returned
+	// rather than logged because logging is configured from the result.
+	Warnings []string
+}
+
+// RateLimit allows Rate requests per second with bursts of up to Burst.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+func (r RateLimit) String() string {
+	return fmt.Sprintf("%s/%d", strconv.FormatFloat(r.Rate, 'f', -1, 64), r.Burst)
+}
+
+// Default returns the configuration used when nothing is set. Game settings
+// default to what the server uses without the matching option.
+func Default() *Config {
+	return &Config{
+		ServerPort:          3232,
+		HTTPPort:            6060,
+		AdminPort:           3434,
+		LogLevel:            slog.LevelInfo,
+		PingInterval:        server2.DefaultPingInterval,
+		LobbySearchLimit:    server2.DefaultLobbySearchLimit,
+		RematchWindow:       server2.DefaultRematchWindow,
+		DisplayNameCooldown: server2.DefaultDisplayNameCooldown,
+		GuestTTL:            server2.DefaultGuestTTL,
+		ClientRateLimit:     RateLimit{Rate: server2.DefaultClientRate, Burst: server2.DefaultClientBurst},
+		ConsumerRateLimit:   RateLimit{Rate: server2.DefaultConsumerRate, Burst: server2.DefaultConsumerBurst},
+		JanitorInterval:     server2.DefaultJanitorInterval,
+		IdleClientTTL:       server2.DefaultIdleClientTTL,
+		ShutdownDrainDelay:  5 * time.Second,
+		TLSClientAuth:       "none",
+		TLSReloadInterval:   10 * time.Second,
+	}
+}
+
+// setting describes one configuration value. Its name is the key in the
+// configuration file; the flag is the name with dashes instead of
+// underscores.
+type setting struct {
+	name    string
+	env     string
+	aliases []string
+	usage   string
+	isBool  bool
+	parse   func(c *Config, value string) error
+	value   func(c *Config) any
+}
+
+func (s setting) flagName() string {
+	return strings.ReplaceAll(s.name, "_", "-")
+}
+
+var settings = []setting{
+	{
+		name: "server_port", env: "TCTXTO_SERVER_PORT",
+		usage: "port the game gRPC service listens on",
+		parse: func(c *Config, v string) error { return parsePort(v, &c.ServerPort) },
+		value: func(c *Config) any { return c.ServerPort },
+	},
+	{
+		name: "http_port", env: "TCTXTO_HTTP_PORT",
+		usage: "port serving metrics, health checks and pprof",
+		parse: func(c *Config, v string) error { return parsePort(v, &c.HTTPPort) },
+		value: func(c *Config) any { return c.HTTPPort },
+	},
+	{
+		name: "admin_port", env: "TCTXTO_ADMIN_PORT",
+		usage: "port the admin gRPC service listens on",
+		parse: func(c *Config, v string) error { return parsePort(v, &c.AdminPort) },
+		value: func(c *Config) any { return c.AdminPort },
+	},
+	{
+		name: "admin_key", env: "TCTXTO_ADMIN_KEY",
+		usage: "key operators must send to use the admin service, which is disabled without one",
+		parse: func(c *Config, v string) error { c.AdminKey = v; return nil },
+		value: func(c *Config) any {
+			if c.AdminKey == "" {
+				return ""
+			}
+			return "<redacted>"
+		},
+	},
+	{
+		name: "websocket_port", env: "TCTXTO_WEBSOCKET_PORT",
+		usage: "port serving the game to WebSocket clients on /ws, disabled when 0",
+		parse: func(c *Config, v string) error { return parsePort(v, &c.WebSocketPort) },
+		value: func(c *Config) any { return c.WebSocketPort },
+	},
+	{
+		name: "websocket_allowed_origins", env: "TCTXTO_WEBSOCKET_ALLOWED_ORIGINS",
+		usage: "comma separated origins browsers may open WebSockets from, * for any; same origin only when empty",
+		parse: func(c *Config, v string) error { c.WebSocketOrigins = parseList(v); return nil },
+		value: func(c *Config) any { return c.WebSocketOrigins },
+	},
+	{
+		name: "log_level", env: "TCTXTO_LOG_LEVEL",
+		usage: "minimum level logged: debug, info, warn or error",
+		parse: func(c *Config, v string) error { return c.LogLevel.UnmarshalText([]byte(v)) },
+		value: func(c *Config) any { return strings.ToLower(c.LogLevel.String()) },
+	},
+	{
+		name: "enable_reflection", env: "TCTXTO_ENABLE_REFLECTION", aliases: []string{"TCTXTO_ENABLE_RELECTION"},
+		usage: "register the gRPC reflection service", isBool: true,
+		parse: func(c *Config, v string) error { return parseBool(v, &c.EnableReflection) },
+		value: func(c *Config) any { return c.EnableReflection },
+	},
+	{
+		name: "consumers", env: "TCTXTO_CONSUMERS",
+		usage: "path to the JSON file listing the consumers allowed to connect",
+		parse: func(c *Config, v string) error { c.Consumers = v; return nil },
+		value: func(c *Config) any { return c.Consumers },
+	},
+	{
+		name: "announcements_file", env: "TCTXTO_ANNOUNCEMENTS_FILE",
+		usage: "path to the JSON file announcements are kept in across restarts",
+		parse: func(c *Config, v string) error { c.AnnouncementsFile = v; return nil },
+		value: func(c *Config) any { return c.AnnouncementsFile },
+	},
+	{
+		name: "tracing_exporter", env: "TCTXTO_TRACING_EXPORTER",
+		usage: "where spans are exported: otlp, stdout, or empty to disable tracing",
+		parse: func(c *Config, v string) error { c.TracingExporter = strings.ToLower(v); return nil },
+		value: func(c *Config) any { return c.TracingExporter },
+	},
+	{
+		name: "ping_interval", env: "TCTXTO_PING_INTERVAL",
+		usage: "how often subscribed clients are pinged",
+		parse: func(c *Config, v string) error { return parseDuration(v, &c.PingInterval) },
+		value: func(c *Config) any { return c.PingInterval.String() },
+	},
+	{
+		name: "lobby_search_limit", env: "TCTXTO_LOBBY_SEARCH_LIMIT",
+		usage: "most lobbies returned by a lobby search",
+		parse: func(c *Config, v string) error { return parseInt(v, &c.LobbySearchLimit) },
+		value: func(c *Config) any { return c.LobbySearchLimit },
+	},
+	{
+		name: "rematch_window", env: "TCTXTO_REMATCH_WINDOW",
+		usage: "how long players have to decide on a rematch, zero or negative to wait for both",
+		parse: func(c *Config, v string) error { return parseDuration(v, &c.RematchWindow) },
+		value: func(c *Config) any { return c.RematchWindow.String() },
+	},
+	{
+		name: "rematch_swap_sides", env: "TCTXTO_REMATCH_SWAP_SIDES",
+		usage: "swap X and O between a game and its rematch", isBool: true,
+		parse: func(c *Config, v string) error { return parseBool(v, &c.RematchSwapSides) },
+		value: func(c *Config) any { return c.RematchSwapSides },
+	},
+	{
+		name: "display_name_deny_list", env: "TCTXTO_DISPLAY_NAME_DENY_LIST",
+		usage: "comma separated words display names may not contain",
+		parse: func(c *Config, v string) error { c.DisplayNameDenyList = parseList(v); return nil },
+		value: func(c *Config) any { return c.DisplayNameDenyList },
+	},
+	{
+		name: "unique_display_names", env: "TCTXTO_UNIQUE_DISPLAY_NAMES",
+		usage: "refuse display names that look like one already in use", isBool: true,
+		parse: func(c *Config, v string) error { return parseBool(v, &c.UniqueDisplayNames) },
+		value: func(c *Config) any { return c.UniqueDisplayNames },
+	},
+	{
+		name: "display_name_cooldown", env: "TCTXTO_DISPLAY_NAME_COOLDOWN",
+		usage: "how long a player must wait between display name changes",
+		parse: func(c *Config, v string) error { return parseDuration(v, &c.DisplayNameCooldown) },
+		value: func(c *Config) any { return c.DisplayNameCooldown.String() },
+	},
+	{
+		name: "guest_ttl", env: "TCTXTO_GUEST_TTL",
+		usage: "how long a guest can stay inactive before it is discarded, zero or negative to keep guests until they sign out",
+		parse: func(c *Config, v string) error { return parseDuration(v, &c.GuestTTL) },
+		value: func(c *Config) any { return c.GuestTTL.String() },
+	},
+	{
+		name: "client_rate_limit", env: "TCTXTO_CLIENT_RATE_LIMIT",
+		usage: "client updates allowed per client, as requests per second/burst",
+		parse: func(c *Config, v string) error { return parseRateLimit(v, &c.ClientRateLimit) },
+		value: func(c *Config) any { return c.ClientRateLimit.String() },
+	},
+	{
+		name: "consumer_rate_limit", env: "TCTXTO_CONSUMER_RATE_LIMIT",
+		usage: "client updates allowed per consumer, as requests per second/burst",
+		parse: func(c *Config, v string) error { return parseRateLimit(v, &c.ConsumerRateLimit) },
+		value: func(c *Config) any { return c.ConsumerRateLimit.String() },
+	},
+	{
+		name: "janitor_interval", env: "TCTXTO_JANITOR_INTERVAL",
+		usage: "how often idle clients and orphaned state are swept, zero or negative to disable",
+		parse: func(c *Config, v string) error { return parseDuration(v, &c.JanitorInterval) },
+		value: func(c *Config) any { return c.JanitorInterval.String() },
+	},
+	{
+		name: "idle_client_ttl", env: "TCTXTO_IDLE_CLIENT_TTL",
+		usage: "how long an unsubscribed client may stay silent before it is evicted",
+		parse: func(c *Config, v string) error { return parseDuration(v, &c.IdleClientTTL) },
+		value: func(c *Config) any { return c.IdleClientTTL.String() },
+	},
+	{
+		name: "shutdown_drain_delay", env: "TCTXTO_SHUTDOWN_DRAIN_DELAY",
+		usage: "how long health checks report not serving before the listeners stop on shutdown",
+		parse: func(c *Config, v string) error { return parseDuration(v, &c.ShutdownDrainDelay) },
+		value: func(c *Config) any { return c.ShutdownDrainDelay.String() },
+	},
+	{
+		name: "tls_cert_file", env: "TCTXTO_TLS_CERT_FILE",
+		usage: "PEM certificate the gRPC listeners serve, TLS is off without one",
+		parse: func(c *Config, v string) error { c.TLSCertFile = v; return nil },
+		value: func(c *Config) any { return c.TLSCertFile },
+	},
+	{
+		name: "tls_key_file", env: "TCTXTO_TLS_KEY_FILE",
+		usage: "PEM private key for the TLS certificate",
+		parse: func(c *Config, v string) error { c.TLSKeyFile = v; return nil },
+		value: func(c *Config) any { return c.TLSKeyFile },
+	},
+	{
+		name: "tls_client_ca_file", env: "TCTXTO_TLS_CLIENT_CA_FILE",
+		usage: "PEM bundle of the CAs client certificates are verified against",
+		parse: func(c *Config, v string) error { c.TLSClientCAFile = v; return nil },
+		value: func(c *Config) any { return c.TLSClientCAFile },
+	},
+	{
+		name: "tls_client_auth", env: "TCTXTO_TLS_CLIENT_AUTH",
+		usage: "whether clients present certificates: none, optional or require",
+		parse: func(c *Config, v string) error { c.TLSClientAuth = strings.ToLower(strings.TrimSpace(v)); return nil },
+		value: func(c *Config) any { return c.TLSClientAuth },
+	},
+	{
+		name: "tls_reload_interval", env: "TCTXTO_TLS_RELOAD_INTERVAL",
+		usage: "how often the TLS files are checked for changes",
+		parse: func(c *Config, v string) error { return parseDuration(v, &c.TLSReloadInterval) },
+		value: func(c *Config) any { return c.TLSReloadInterval.String() },
+	},
+}
+
+// Load builds the configuration from the command-line arguments, without
+// the program name, and the environment. A .env file is read into the
+// environment first if there is one. It returns flag.ErrHelp when usage
+// was asked for.
+func Load(args []string) (*Config, error) {
+	c := Default()
+
+	flags := flag.NewFlagSet("tctxto", flag.ContinueOnError)
+	configFile := flags.String("config", "", "path to a YAML or TOML configuration file (env TCTXTO_CONFIG)")
+	envFile := flags.String("env-file", ".env", "path to an optional file of environment variables")
+	flags.BoolVar(&c.PrintConfig, "print-config", false, "print the effective configuration as YAML and exit")
+
+	type flagValue struct {
+		setting setting
+		value   string
+	}
+	flagValues := []flagValue{}
+	for _, s := range settings {
+		record := func(value string) error {
+			flagValues = append(flagValues, flagValue{s, value})
+			return nil
+		}
+		usage := fmt.Sprintf("%s (env %s)", s.usage, s.env)
+		if s.isBool {
+			flags.BoolFunc(s.flagName(), usage, record)
+		} else {
+			flags.Func(s.flagName(), usage, record)
+		}
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+	if flags.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected arguments: %s", strings.Join(flags.Args(), " "))
+	}
+
+	if err := godotenv.Load(*envFile); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("reading %s: %w", *envFile, err)
+	}
+
+	if *configFile == "" {
+		*configFile = os.Getenv("TCTXTO_CONFIG")
+	}
+	if *configFile != "" {
+		if err := c.loadFile(*configFile); err != nil {
+			return nil, err
+		}
+	}
+
+	errs := []error{}
+
+	for _, s := range settings {
+		value, source, found := lookupEnv(s)
+		if !found {
+			continue
+		}
+		if source != s.env {
+			c.Warnings = append(c.Warnings, fmt.Sprintf("%s is deprecated, use %s instead", source, s.env))
+		}
+		if err := s.parse(c, value); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", source, err))
+		}
+	}
+
+	for _, fv := range flagValues {
+		if err := fv.setting.parse(c, fv.value); err != nil {
+			errs = append(errs, fmt.Errorf("-%s: %w", fv.setting.flagName(), err))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	// The effective configuration is printed even when it does not validate,
+	// since that is when it is most useful to see. Validating it is left to
+	// the caller.
+	if c.PrintConfig {
+		return c, nil
+	}
+
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// lookupEnv finds the setting's environment variable, falling back to its
+// deprecated names.
+func lookupEnv(s setting) (string, string, bool) {
+	if value, found := os.LookupEnv(s.env); found {
+		return value, s.env, true
+	}
+	for _, alias := range s.aliases {
+		if value, found := os.LookupEnv(alias); found {
+			return value, alias, true
+		}
+	}
+	return "", "", false
+}
+
+// loadFile applies the settings in a configuration file. The format follows
+// the extension: .yaml or .yml for YAML and .toml for TOML.
+func (c *Config) loadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	values := map[string]any{}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &values)
+	case ".toml":
+		err = toml.Unmarshal(data, &values)
+	default:
+		return fmt.Errorf("%s: configuration files must be .yaml, .yml or .toml", path)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	byName := map[string]setting{}
+	for _, s := range settings {
+		byName[s.name] = s
+	}
+
+	errs := []error{}
+	for name, raw := range values {
+		s, known := byName[name]
+		if !known {
+			errs = append(errs, fmt.Errorf("%s: unknown setting %q", path, name))
+			continue
+		}
+
+		value, err := fileValue(raw)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %s: %w", path, name, err))
+			continue
+		}
+
+		if err := s.parse(c, value); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %s: %w", path, name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// fileValue turns a decoded YAML or TOML value back into the text form the
+// settings parse, so every source goes through the same parsing.
+func fileValue(raw any) (string, error) {
+	switch v := raw.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case bool, int, int64, uint64, float64:
+		return fmt.Sprint(v), nil
+	case []any:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			value, err := fileValue(item)
+			if err != nil {
+				return "", err
+			}
+			items = append(items, value)
+		}
+		return strings.Join(items, ","), nil
+	default:
+		return "", fmt.Errorf("unsupported value %v", raw)
+	}
+}
+
+// Validate checks the values make sense together.
+func (c *Config) Validate() error {
+	errs := []error{}
+
+	type namedPort struct {
+		name  string
+		value int
+	}
+	namedPorts := []namedPort{
+		{"server_port", c.ServerPort},
+		{"http_port", c.HTTPPort},
+		{"admin_port", c.AdminPort},
+	}
+	if c.WebSocketPort != 0 {
+		namedPorts = append(namedPorts, namedPort{"websocket_port", c.WebSocketPort})
+	}
+
+	ports := map[int]string{}
+	for _, port := range namedPorts {
+		if port.value < 1 || port.value > 65535 {
+			errs = append(errs, fmt.Errorf("%s: %d is not a valid port", port.name, port.value))
+			continue
+		}
+		if other, taken := ports[port.value]; taken {
+			errs = append(errs, fmt.Errorf("%s: port %d is already used by %s", port.name, port.value, other))
+		}
+		ports[port.value] = port.name
+	}
+
+	if c.Consumers == "" {
+		errs = append(errs, errors.New("consumers: the path to the consumers JSON file is required"))
+	}
+
+	switch c.TracingExporter {
+	case "", "otlp", "stdout":
+	default:
+		errs = append(errs, fmt.Errorf("tracing_exporter: %q is not otlp or stdout", c.TracingExporter))
+	}
+
+	if c.PingInterval <= 0 {
+		errs = append(errs, errors.New("ping_interval: must be positive"))
+	}
+	if c.LobbySearchLimit <= 0 {
+		errs = append(errs, errors.New("lobby_search_limit: must be positive"))
+	}
+	if c.DisplayNameCooldown < 0 {
+		errs = append(errs, errors.New("display_name_cooldown: can not be negative"))
+	}
+	if c.IdleClientTTL <= 0 {
+		errs = append(errs, errors.New("idle_client_ttl: must be positive"))
+	}
+	if c.ShutdownDrainDelay < 0 {
+		errs = append(errs, errors.New("shutdown_drain_delay: can not be negative"))
+	}
+
+	if (c.TLSCertFile == "") != (c.TLSKeyFile == "") {
+		errs = append(errs, errors.New("tls_cert_file and tls_key_file: set both or neither"))
+	}
+	switch c.TLSClientAuth {
+	case "none":
+	case "optional", "require":
+		if c.TLSClientCAFile == "" {
+			errs = append(errs, fmt.Errorf("tls_client_auth: %s needs tls_client_ca_file", c.TLSClientAuth))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("tls_client_auth: %q is not none, optional or require", c.TLSClientAuth))
+	}
+	if c.TLSClientCAFile != "" && c.TLSCertFile == "" {
+		errs = append(errs, errors.New("tls_client_ca_file: needs tls_cert_file and tls_key_file"))
+	}
+	if c.TLSReloadInterval <= 0 {
+		errs = append(errs, errors.New("tls_reload_interval: must be positive"))
+	}
+
+	for _, limit := range []struct {
+		name  string
+		value RateLimit
+	}{
+		{"client_rate_limit", c.ClientRateLimit},
+		{"consumer_rate_limit", c.ConsumerRateLimit},
+	} {
+		if limit.value.Rate <= 0 || limit.value.Burst <= 0 {
+			errs = append(errs, fmt.Errorf("%s: rate and burst must be positive", limit.name))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// TLSEnabled reports whether the gRPC listeners serve TLS.
+func (c *Config) TLSEnabled() bool {
+	return c.TLSCertFile != ""
+}
+
+// ClientAuthType maps TLSClientAuth to how client certificates are checked.
+func (c *Config) ClientAuthType() tls.ClientAuthType {
+	switch c.TLSClientAuth {
+	case "optional":
+		return tls.VerifyClientCertIfGiven
+	case "require":
+		return tls.RequireAndVerifyClientCert
+	default:
+		return tls.NoClientCert
+	}
+}
+
+// WriteYAML writes the configuration in the configuration file format, with
+// the admin key redacted.
+func (c *Config) WriteYAML(w io.Writer) error {
+	root := &yaml.Node{Kind: yaml.MappingNode}
+	for _, s := range settings {
+		value := &yaml.Node{}
+		if err := value.Encode(s.value(c)); err != nil {
+			return err
+		}
+		root.Content = append(root.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: s.name}, value)
+	}
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(root); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+func parsePort(value string, port *int) error {
+	n, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		return fmt.Errorf("%q is not a port number", value)
+	}
+	*port = n
+	return nil
+}
+
+func parseInt(value string, n *int) error {
+	parsed, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		return fmt.Errorf("%q is not a whole number", value)
+	}
+	*n = parsed
+	return nil
+}
+
+func parseBool(value string, b *bool) error {
+	parsed, err := strconv.ParseBool(strings.ToLower(strings.TrimSpace(value)))
+	if err != nil {
+		return fmt.Errorf("%q is not true or false", value)
+	}
+	*b = parsed
+	return nil
+}
+
+func parseDuration(value string, d *time.Duration) error {
+	parsed, err := time.ParseDuration(strings.TrimSpace(value))
+	if err != nil {
+		return fmt.Errorf("%q is not a duration such as 30s or 5m", value)
+	}
+	*d = parsed
+	return nil
+}
+
+func parseList(value string) []string {
+	list := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// parseRateLimit reads a rate limit written as "<requests per second>/<burst>",
+// for example "10/20".
+func parseRateLimit(value string, limit *RateLimit) error {
+	rateStr, burstStr, found := strings.Cut(value, "/")
+	if !found {
+		return fmt.Errorf("%q is not in the form rate/burst", value)
+	}
+
+	rate, err := strconv.ParseFloat(strings.TrimSpace(rateStr), 64)
+	if err != nil {
+		return fmt.Errorf("%q is not in the form rate/burst", value)
+	}
+
+	burst, err := strconv.Atoi(strings.TrimSpace(burstStr))
+	if err != nil {
+		return fmt.Errorf("%q is not in the form rate/burst", value)
+	}
+
+	*limit = RateLimit{Rate: rate, Burst: burst}
+	return nil
+}
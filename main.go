@@ -1,50 +1,103 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
 	"log"
+	"log/slog"
 	"net"
 	"os"
+	"os/signal"
 	"strconv"
 	"strings"
+	"syscall"
+	"time"
+	"txtcto/config"
 	"txtcto/models"
 	"txtcto/server2"
+	"txtcto/tlsconfig"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
 	"net/http"
 	_ "net/http/pprof"
-
-	"github.com/joho/godotenv"
 )
 
 func main() {
-	err := godotenv.Load()
+	cfg, err := config.Load(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
 	if err != nil {
-		log.Fatalln("The .env file is not found. It should have TCTXTO_SERVER_PORT=3232, TCTXTO_ENABLE_RELECTION=false, and TCTXTO_CONSUMERS=?.")
+		log.Fatalf("invalid configuration:\n%v\n", err)
 	}
 
-	port := os.Getenv("TCTXTO_SERVER_PORT")
-	enableReflectionStr := os.Getenv("TCTXTO_ENABLE_RELECTION")
-	consumersPath := os.Getenv("TCTXTO_CONSUMERS")
-
-	if len(port) == 0 {
-		port = "3232"
+	for _, warning := range cfg.Warnings {
+		log.Printf("warning: %s\n", warning)
 	}
 
-	reflectionEnabled := false
-	if enableReflectionStr != "" {
-		var err error
-		reflectionEnabled, err = strconv.ParseBool(strings.ToLower(enableReflectionStr))
-		if err != nil {
-			log.Printf("warning: invalid value for TCTXTO_ENABLE_RELECTION: %q, defaulting to false\n", enableReflectionStr)
+	if cfg.PrintConfig {
+		if err := cfg.WriteYAML(os.Stdout); err != nil {
+			log.Fatalf("error printing the configuration: %v\n", err)
 		}
+		if err := cfg.Validate(); err != nil {
+			log.Fatalf("invalid configuration:\n%v\n", err)
+		}
+		return
+	}
+
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: cfg.LogLevel}))
+	slog.SetDefault(logger)
+
+	port := strconv.Itoa(cfg.ServerPort)
+	httpPort := strconv.Itoa(cfg.HTTPPort)
+	adminPort := strconv.Itoa(cfg.AdminPort)
+	adminKey := cfg.AdminKey
+	reflectionEnabled := cfg.EnableReflection
+	consumersPath := cfg.Consumers
+
+	serverOpts := []server2.Option{
+		server2.WithLogger(logger),
+		server2.WithPingInterval(cfg.PingInterval),
+		server2.WithLobbySearchLimit(cfg.LobbySearchLimit),
+		server2.WithRematchWindow(cfg.RematchWindow),
+		server2.WithRematchSwapSides(cfg.RematchSwapSides),
+		server2.WithDisplayNameDenyList(cfg.DisplayNameDenyList),
+		server2.WithUniqueDisplayNames(cfg.UniqueDisplayNames),
+		server2.WithDisplayNameCooldown(cfg.DisplayNameCooldown),
+		server2.WithGuestTTL(cfg.GuestTTL),
+		server2.WithClientRateLimit(cfg.ClientRateLimit.Rate, cfg.ClientRateLimit.Burst),
+		server2.WithConsumerRateLimit(cfg.ConsumerRateLimit.Rate, cfg.ConsumerRateLimit.Burst),
+		server2.WithJanitorInterval(cfg.JanitorInterval),
+		server2.WithIdleClientTTL(cfg.IdleClientTTL),
+	}
+
+	if cfg.AnnouncementsFile != "" {
+		serverOpts = append(serverOpts, server2.WithAnnouncementsFile(cfg.AnnouncementsFile))
 	}
 
-	if consumersPath == "" {
-		log.Fatalln("need to specify the path to the consumers JSON file (TCTXTO_CONSUMERS environment variable)")
+	if cfg.TracingExporter != "" {
+		tracerProvider, err := newTracerProvider(cfg.TracingExporter)
+		if err != nil {
+			log.Printf("warning: tracing disabled: %v\n", err)
+		} else {
+			defer tracerProvider.Shutdown(context.Background())
+			otel.SetTracerProvider(tracerProvider)
+			otel.SetTextMapPropagator(propagation.TraceContext{})
+			serverOpts = append(serverOpts, server2.WithTracerProvider(tracerProvider))
+		}
 	}
 
 	consumersData, err := os.ReadFile(consumersPath)
@@ -67,22 +120,61 @@ func main() {
 		log.Fatalf("failed to listen: %v\n", err)
 	}
 
-	s := grpc.NewServer()
+	consumersMap := make(map[string]*models.Consumer)
+	for _, consumer := range consumers {
+		consumersMap[consumer.PublicKey] = consumer
+	}
+
+	tictactoe := server2.NewServer(consumersMap, serverOpts...)
+
+	grpcOpts := []grpc.ServerOption{}
+	var reloader *tlsconfig.Reloader
+	if cfg.TLSEnabled() {
+		reloader, err = tlsconfig.NewReloader(cfg.TLSCertFile, cfg.TLSKeyFile, cfg.TLSClientCAFile, cfg.ClientAuthType())
+		if err != nil {
+			log.Fatalf("error loading the TLS certificates: %v\n", err)
+		}
+		go reloader.Watch(context.Background(), cfg.TLSReloadInterval, logger)
+		grpcOpts = append(grpcOpts, grpc.Creds(credentials.NewTLS(reloader.ServerConfig("h2"))))
+	} else {
+		log.Println("warning: TLS is not configured, player passwords are sent in cleartext")
+	}
+
+	s := grpc.NewServer(append(grpcOpts,
+		grpc.ChainUnaryInterceptor(tictactoe.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(tictactoe.StreamServerInterceptor()),
+	)...)
 
 	if reflectionEnabled {
 		reflection.Register(s)
 	}
 
-	consumersMap := make(map[string]*models.Consumer)
-	for _, consumer := range consumers {
-		consumersMap[consumer.PublicKey] = consumer
-	}
+	server2.RegisterTicTacToeServer(s, tictactoe)
+
+	// Report NOT_SERVING until the listener is about to accept connections.
+	// There is no persistence backend yet, so the process being up and not
+	// shutting down is all readiness means.
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	healthServer.SetServingStatus(server2.TicTacToe_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_NOT_SERVING)
+	healthpb.RegisterHealthServer(s, healthServer)
 
-	server2.RegisterTicTacToeServer(s, server2.NewServer(consumersMap))
+	http.Handle("/metrics", tictactoe.MetricsHandler())
+	http.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "ok")
+	})
+	http.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		resp, err := healthServer.Check(r.Context(), &healthpb.HealthCheckRequest{})
+		if err != nil || resp.Status != healthpb.HealthCheckResponse_SERVING {
+			http.Error(w, "not ready", http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintln(w, "ok")
+	})
 
-	// Start a separate HTTP server for pprof (choose a different port)
+	// Start a separate HTTP server for pprof and metrics
 	go func() {
-		pprofPort := ":6060" // Example port
+		pprofPort := fmt.Sprintf(":%s", httpPort)
 		addrs, err := net.InterfaceAddrs()
 		if err != nil {
 			log.Fatalf("error getting network interfaces: %v\n", err)
@@ -91,7 +183,7 @@ func main() {
 			if ipnet, ok := addr.(*net.IPNet); ok && !ipnet.IP.IsLoopback() {
 				if ipnet.IP.To4() != nil {
 					localIP := ipnet.IP.String()
-					log.Printf("tctxto server pprof running on http://%s%s\n", localIP, pprofPort)
+					log.Printf("tctxto server pprof and metrics running on http://%s%s\n", localIP, pprofPort)
 				}
 			}
 		}
@@ -113,7 +205,118 @@ func main() {
 		}
 	}
 
+	var adminServer *grpc.Server
+	if adminKey == "" {
+		log.Println("TCTXTO_ADMIN_KEY is not set, the admin service is disabled")
+	} else {
+		adminLis, err := net.Listen("tcp", fmt.Sprintf(":%s", adminPort))
+		if err != nil {
+			log.Fatalf("failed to listen on the admin port: %v\n", err)
+		}
+
+		admin := server2.NewAdminServer(tictactoe, adminKey)
+		adminServer = grpc.NewServer(append(grpcOpts, grpc.ChainUnaryInterceptor(admin.UnaryServerInterceptor()))...)
+		if reflectionEnabled {
+			reflection.Register(adminServer)
+		}
+		server2.RegisterTicTacToeAdminServer(adminServer, admin)
+
+		go func() {
+			log.Printf("tctxto admin server running on tcp://:%s\n", adminPort)
+			if err := adminServer.Serve(adminLis); err != nil {
+				log.Fatalf("tctxto admin server failed to serve: %v\n", err)
+			}
+		}()
+	}
+
+	var webSocketServer *http.Server
+	if cfg.WebSocketPort != 0 {
+		webSocketPort := strconv.Itoa(cfg.WebSocketPort)
+		mux := http.NewServeMux()
+		mux.Handle("/ws", tictactoe.WebSocketHandler(cfg.WebSocketOrigins))
+		webSocketServer = &http.Server{Addr: fmt.Sprintf(":%s", webSocketPort), Handler: mux}
+
+		go func() {
+			var err error
+			if reloader != nil {
+				webSocketServer.TLSConfig = reloader.ServerConfig("http/1.1")
+				log.Printf("tctxto websocket server running on wss://:%s/ws\n", webSocketPort)
+				err = webSocketServer.ListenAndServeTLS("", "")
+			} else {
+				log.Printf("tctxto websocket server running on ws://:%s/ws\n", webSocketPort)
+				err = webSocketServer.ListenAndServe()
+			}
+			if err != nil && err != http.ErrServerClosed {
+				log.Fatalf("tctxto websocket server failed to serve: %v\n", err)
+			}
+		}()
+	}
+
+	go func() {
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+		<-signals
+
+		log.Println("tctxto server shutting down")
+		healthServer.Shutdown()
+		// Give load balancers time to see the health checks fail and stop
+		// sending new connections before the listeners close.
+		time.Sleep(cfg.ShutdownDrainDelay)
+		if adminServer != nil {
+			adminServer.GracefulStop()
+		}
+		if webSocketServer != nil {
+			// Shutdown does not wait for upgraded connections, they end with
+			// the process.
+			webSocketServer.Shutdown(context.Background())
+		}
+		gracefulStop(s, shutdownGracePeriod)
+	}()
+
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	healthServer.SetServingStatus(server2.TicTacToe_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+
 	if err := s.Serve(lis); err != nil {
 		log.Fatalf("tctxto server failed to serve: %v\n", err)
 	}
 }
+
+const shutdownGracePeriod = 10 * time.Second
+
+// gracefulStop lets in-flight calls finish, but subscriptions stay open until
+// the client leaves, so after the grace period the remaining ones are cut off.
+func gracefulStop(s *grpc.Server, gracePeriod time.Duration) {
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(gracePeriod):
+		s.Stop()
+	}
+}
+
+// newTracerProvider builds a tracer provider that batches spans to the named
+// exporter. "otlp" sends them over gRPC to the collector configured by the
+// standard OTEL_EXPORTER_OTLP_* variables, "stdout" prints them.
+func newTracerProvider(exporterName string) (*sdktrace.TracerProvider, error) {
+	var exporter sdktrace.SpanExporter
+	var err error
+
+	switch strings.ToLower(exporterName) {
+	case "otlp":
+		exporter, err = otlptracegrpc.New(context.Background())
+	case "stdout":
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q, expected otlp or stdout", exporterName)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter)), nil
+}
@@ -1,8 +1,19 @@
 package models
 
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
 type Consumer struct {
 	PublicKey string `json:"public_key"`
 	Name      string `json:"name"`
+	// CertificateSubject, when set, is the subject of the client certificate
+	// the consumer connects with, for example "CN=web,O=Example". Such a
+	// consumer is identified by its certificate and not by the PublicKey
+	// header.
+	CertificateSubject string `json:"certificate_subject,omitempty"`
 }
 
 type Client struct {
@@ -14,29 +25,62 @@ type Lobby struct {
 	Name    string
 	Creator *Player
 	Players map[string]*Player
+	GameId  string
+	Mode    LobbyMode
+
+	// mu guards readyPlayers and playQueue, which handlers and timers change
+	// from different goroutines.
+	mu           sync.Mutex
+	readyPlayers map[string]bool
+	playQueue    []string
 }
 
 type Player struct {
-	Id          string
-	Name        string
-	Pass        string
-	DisplayName string
+	Id                     string
+	Name                   string
+	Pass                   string
+	DisplayName            string
+	DisplayNameChangedAt   time.Time
+	Rating                 int32
+	Friends                map[string]bool
+	IncomingFriendRequests map[string]bool
+	OutgoingFriendRequests map[string]bool
+	Blocked                map[string]bool
+	Guest                  bool
+	LastActiveAt           time.Time
+	Bans                   []*Ban
 }
 
 type Game struct {
+	Id           string
+	LobbyId      string
+	TournamentId string
+	SeriesId     string
+	Board        [9]string
+	Creator      *Player
+	Mover        *Player
+	FirstMover   *Player
+	MoverX       *Player
+	MoverO       *Player
+	Winner       *Player
+	Result       GameResult
+}
+
+type Series struct {
 	Id      string
-	Board   [9]string
-	Creator *Player
-	Mover   *Player
-	MoverX  *Player
-	MoverO  *Player
-	Winner  *Player
-	Result  GameResult
+	BestOf  int32
+	Players [2]*Player
+	Wins    [2]int32
+	Draws   int32
+	GameIds []string
 }
 
 type Rematch struct {
 	Id              string
+	GameId          string
 	PlayerDecisions [2]*PlayerDecision
+	BestOf          int32
+	ExpiresAt       time.Time
 }
 
 type PlayerDecision struct {
@@ -44,6 +88,78 @@ type PlayerDecision struct {
 	Decision Decision
 }
 
+type LobbyInvitation struct {
+	Id        string
+	Lobby     *Lobby
+	Inviter   *Player
+	Invitee   *Player
+	ExpiresAt time.Time
+	Status    InvitationStatus
+}
+
+type Challenge struct {
+	Id         string
+	Challenger *Player
+	Challengee *Player
+	BestOf     int32
+	ExpiresAt  time.Time
+	Status     InvitationStatus
+}
+
+type Tournament struct {
+	Id                 string
+	Name               string
+	Creator            *Player
+	Format             TournamentFormat
+	Status             TournamentStatus
+	RegistrationEndsAt time.Time
+	Entrants           []*TournamentEntrant
+	Spectators         map[string]bool
+	Round              int32
+	Matches            []*TournamentMatch
+	EndedAt            time.Time
+}
+
+type TournamentEntrant struct {
+	Player          *Player
+	Seed            int32
+	Wins            int32
+	Draws           int32
+	Losses          int32
+	EliminatedRound int32
+}
+
+type TournamentMatch struct {
+	Id       string
+	Round    int32
+	Player1  *Player
+	Player2  *Player
+	GameId   string
+	Winner   *Player
+	Finished bool
+}
+
+type Ban struct {
+	Reason    string
+	BannedAt  time.Time
+	ExpiresAt time.Time
+	LiftedAt  time.Time
+}
+
+type Announcement struct {
+	Id        string               `json:"id"`
+	Message   string               `json:"message"`
+	Severity  AnnouncementSeverity `json:"severity"`
+	CreatedAt time.Time            `json:"created_at"`
+	ExpiresAt time.Time            `json:"expires_at"`
+}
+
+type SignInAttempts struct {
+	Failures      int32
+	LastFailureAt time.Time
+	LockedUntil   time.Time
+}
+
 type GameResult int32
 
 const (
@@ -54,6 +170,38 @@ const (
 	GameResult_WIN_BY_FORFEIT GameResult = 4
 )
 
+type AnnouncementSeverity int32
+
+const (
+	AnnouncementSeverity_INFO     AnnouncementSeverity = 0
+	AnnouncementSeverity_WARNING  AnnouncementSeverity = 1
+	AnnouncementSeverity_CRITICAL AnnouncementSeverity = 2
+)
+
+type LobbyMode int32
+
+const (
+	LobbyMode_STANDARD        LobbyMode = 0
+	LobbyMode_WINNER_STAYS_ON LobbyMode = 1
+)
+
+type TournamentFormat int32
+
+const (
+	TournamentFormat_SINGLE_ELIMINATION TournamentFormat = 0
+	TournamentFormat_DOUBLE_ELIMINATION TournamentFormat = 1
+	TournamentFormat_ROUND_ROBIN        TournamentFormat = 2
+)
+
+type TournamentStatus int32
+
+const (
+	TournamentStatus_REGISTRATION TournamentStatus = 0
+	TournamentStatus_IN_PROGRESS  TournamentStatus = 1
+	TournamentStatus_FINISHED     TournamentStatus = 2
+	TournamentStatus_CANCELLED    TournamentStatus = 3
+)
+
 type Decision int32
 
 const (
@@ -62,6 +210,86 @@ const (
 	Decision_NO        Decision = 2
 )
 
+type InvitationStatus int32
+
+const (
+	InvitationStatus_PENDING   InvitationStatus = 0
+	InvitationStatus_ACCEPTED  InvitationStatus = 1
+	InvitationStatus_DECLINED  InvitationStatus = 2
+	InvitationStatus_EXPIRED   InvitationStatus = 3
+	InvitationStatus_WITHDRAWN InvitationStatus = 4
+)
+
+func (p *Player) AddFriend(playerId string) {
+	if p.Friends == nil {
+		p.Friends = make(map[string]bool)
+	}
+	p.Friends[playerId] = true
+	delete(p.IncomingFriendRequests, playerId)
+	delete(p.OutgoingFriendRequests, playerId)
+}
+
+func (p *Player) RemoveFriend(playerId string) {
+	delete(p.Friends, playerId)
+	delete(p.IncomingFriendRequests, playerId)
+	delete(p.OutgoingFriendRequests, playerId)
+}
+
+func (p *Player) AddIncomingFriendRequest(playerId string) {
+	if p.IncomingFriendRequests == nil {
+		p.IncomingFriendRequests = make(map[string]bool)
+	}
+	p.IncomingFriendRequests[playerId] = true
+}
+
+func (p *Player) AddOutgoingFriendRequest(playerId string) {
+	if p.OutgoingFriendRequests == nil {
+		p.OutgoingFriendRequests = make(map[string]bool)
+	}
+	p.OutgoingFriendRequests[playerId] = true
+}
+
+func (p *Player) Block(playerId string) {
+	if p.Blocked == nil {
+		p.Blocked = make(map[string]bool)
+	}
+	p.Blocked[playerId] = true
+	p.RemoveFriend(playerId)
+}
+
+func (p *Player) Unblock(playerId string) {
+	delete(p.Blocked, playerId)
+}
+
+// ActiveAt reports whether the announcement should still be shown.
+func (a *Announcement) ActiveAt(now time.Time) bool {
+	return a.ExpiresAt.IsZero() || now.Before(a.ExpiresAt)
+}
+
+// ActiveBan returns the ban currently keeping the player out, if any. Bans
+// that ran out or were lifted stay in Bans as history.
+func (p *Player) ActiveBan(now time.Time) (*Ban, bool) {
+	for _, ban := range p.Bans {
+		if ban.ActiveAt(now) {
+			return ban, true
+		}
+	}
+	return nil, false
+}
+
+// Permanent reports whether the ban lasts until it is lifted.
+func (b *Ban) Permanent() bool {
+	return b.ExpiresAt.IsZero()
+}
+
+func (b *Ban) ActiveAt(now time.Time) bool {
+	return b.LiftedAt.IsZero() && (b.Permanent() || now.Before(b.ExpiresAt))
+}
+
+func (g *Game) Ended() bool {
+	return g.Result == GameResult_DRAW || g.Result == GameResult_WIN || g.Result == GameResult_WIN_BY_FORFEIT
+}
+
 func (r *Rematch) Confirmed() bool {
 	for _, pd := range r.PlayerDecisions {
 		if pd.Decision == Decision_UNDECIDED || pd.Decision == Decision_NO {
@@ -106,3 +334,180 @@ func (r *Rematch) SetPlayerDecision(playerId string, decision Decision) {
 		}
 	}
 }
+
+func (l *Lobby) SetReady(playerId string, ready bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !ready {
+		delete(l.readyPlayers, playerId)
+		return
+	}
+	if l.readyPlayers == nil {
+		l.readyPlayers = make(map[string]bool)
+	}
+	l.readyPlayers[playerId] = true
+}
+
+func (l *Lobby) IsReady(playerId string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.readyPlayers[playerId]
+}
+
+// ReadyPlayerIds returns the ids of the ready players in a stable order.
+func (l *Lobby) ReadyPlayerIds() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	playerIds := make([]string, 0, len(l.readyPlayers))
+	for playerId := range l.readyPlayers {
+		playerIds = append(playerIds, playerId)
+	}
+	slices.Sort(playerIds)
+	return playerIds
+}
+
+// PlayQueue returns a copy of the winner stays on queue, front first.
+func (l *Lobby) PlayQueue() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return slices.Clone(l.playQueue)
+}
+
+func (l *Lobby) Queued(playerId string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return slices.Contains(l.playQueue, playerId)
+}
+
+// Enqueue adds the player to the back of the queue. It reports false if the
+// player was already queued.
+func (l *Lobby) Enqueue(playerId string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if slices.Contains(l.playQueue, playerId) {
+		return false
+	}
+	l.playQueue = append(l.playQueue, playerId)
+	return true
+}
+
+// Dequeue removes the player from the queue. It reports false if the player
+// was not queued.
+func (l *Lobby) Dequeue(playerId string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.dequeue(playerId)
+}
+
+func (l *Lobby) dequeue(playerId string) bool {
+	queued := slices.Contains(l.playQueue, playerId)
+	l.playQueue = slices.DeleteFunc(l.playQueue, func(id string) bool {
+		return id == playerId
+	})
+	return queued
+}
+
+func (l *Lobby) ClearPlayQueue() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.playQueue = nil
+}
+
+// RotatePlayQueue requeues the players of a finished game that were queued:
+// the winner goes to the front and the loser to the back, and after a draw
+// both go to the back. winnerId is empty for a draw.
+func (l *Lobby) RotatePlayQueue(playerIds []string, winnerId string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	queued := map[string]bool{}
+	for _, playerId := range playerIds {
+		queued[playerId] = l.dequeue(playerId)
+	}
+
+	for _, playerId := range playerIds {
+		if !queued[playerId] {
+			continue
+		}
+		if playerId == winnerId {
+			l.playQueue = append([]string{playerId}, l.playQueue...)
+		} else {
+			l.playQueue = append(l.playQueue, playerId)
+		}
+	}
+}
+
+func (i *LobbyInvitation) Expired(now time.Time) bool {
+	return !now.Before(i.ExpiresAt)
+}
+
+func (c *Challenge) Expired(now time.Time) bool {
+	return !now.Before(c.ExpiresAt)
+}
+
+func (t *Tournament) GetEntrant(playerId string) (*TournamentEntrant, bool) {
+	for _, entrant := range t.Entrants {
+		if entrant.Player.Id == playerId {
+			return entrant, true
+		}
+	}
+	return nil, false
+}
+
+func (t *Tournament) GetMatchByGame(gameId string) (*TournamentMatch, bool) {
+	for _, match := range t.Matches {
+		if match.GameId == gameId {
+			return match, true
+		}
+	}
+	return nil, false
+}
+
+func (t *Tournament) RoundFinished(round int32) bool {
+	for _, match := range t.Matches {
+		if match.Round == round && !match.Finished {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *TournamentEntrant) Points() int32 {
+	return e.Wins*2 + e.Draws
+}
+
+func (s *Series) GamesPlayed() int32 {
+	return s.Wins[0] + s.Wins[1] + s.Draws
+}
+
+func (s *Series) Decided() bool {
+	needed := s.BestOf/2 + 1
+	return s.Wins[0] >= needed || s.Wins[1] >= needed || s.GamesPlayed() >= s.BestOf
+}
+
+func (s *Series) Winner() *Player {
+	if !s.Decided() || s.Wins[0] == s.Wins[1] {
+		return nil
+	}
+	if s.Wins[0] > s.Wins[1] {
+		return s.Players[0]
+	}
+	return s.Players[1]
+}
+
+func (s *Series) PlayerIndex(playerId string) int {
+	for i, player := range s.Players {
+		if player.Id == playerId {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,80 @@
+package server2
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+)
+
+func (s *Server) addFriend(ctx context.Context, clientId string, in *AddFriendRequest) error {
+	player, outcome := s.validatePlayer(ctx, clientId)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createAddFriendReply(outcome))
+		return nil
+	}
+
+	otherId, exists := s.playerNameId.get(in.PlayerName)
+	if !exists {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createAddFriendReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.NotFound),
+			ErrorMessage: "player with name not found",
+		}))
+		return nil
+	}
+
+	other, exists := s.players.get(otherId)
+	if !exists {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createAddFriendReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.NotFound),
+			ErrorMessage: "player not found",
+		}))
+		return nil
+	}
+
+	if other.Id == player.Id {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createAddFriendReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.InvalidArgument),
+			ErrorMessage: "you can not add yourself as a friend",
+		}))
+		return nil
+	}
+
+	if outcome := s.validateNotBlocked(player, other); !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createAddFriendReply(outcome))
+		return nil
+	}
+
+	if player.Friends[other.Id] {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createAddFriendReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.AlreadyExists),
+			ErrorMessage: "player is already your friend",
+		}))
+		return nil
+	}
+
+	if player.OutgoingFriendRequests[other.Id] {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createAddFriendReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.AlreadyExists),
+			ErrorMessage: "friend request has already been sent",
+		}))
+		return nil
+	}
+
+	if player.IncomingFriendRequests[other.Id] {
+		player.AddFriend(other.Id)
+		other.AddFriend(player.Id)
+	} else {
+		player.AddOutgoingFriendRequest(other.Id)
+		other.AddIncomingFriendRequest(player.Id)
+	}
+
+	s.queueServerUpdatesAndSignal(ctx, clientId, s.createAddFriendReply(&Outcome{Ok: true}))
+	s.queueFriendListUpdate(ctx, player, other)
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package server2
+
+import (
+	"context"
+	"strings"
+	"time"
+	"txtcto/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (a *AdminServer) CreateAnnouncement(ctx context.Context, in *CreateAnnouncementRequest) (*Announcement, error) {
+	s := a.server
+
+	message := strings.TrimSpace(in.Message)
+	if message == "" {
+		return nil, status.Error(codes.InvalidArgument, "message can not be empty")
+	}
+
+	if in.DurationSeconds < 0 {
+		return nil, status.Error(codes.InvalidArgument, "announcement duration can not be negative")
+	}
+
+	if _, known := AnnouncementSeverity_name[int32(in.Severity)]; !known {
+		return nil, status.Error(codes.InvalidArgument, "unknown announcement severity")
+	}
+
+	announcement := s.createAnnouncement(ctx, message, models.AnnouncementSeverity(in.Severity), time.Duration(in.DurationSeconds)*time.Second)
+
+	return s.toAnnouncement(announcement), nil
+}
+
+func (a *AdminServer) ListAnnouncements(ctx context.Context, in *ListAnnouncementsRequest) (*ListAnnouncementsReply, error) {
+	s := a.server
+
+	reply := &ListAnnouncementsReply{}
+	for _, announcement := range s.activeAnnouncements(time.Now()) {
+		reply.Announcements = append(reply.Announcements, s.toAnnouncement(announcement))
+	}
+	reply.MaintenanceMode, reply.MaintenanceMessage = s.maintenance.get()
+
+	return reply, nil
+}
+
+func (a *AdminServer) DeleteAnnouncement(ctx context.Context, in *DeleteAnnouncementRequest) (*Empty, error) {
+	if !a.server.withdrawAnnouncement(ctx, in.Id) {
+		return nil, status.Error(codes.NotFound, "announcement not found")
+	}
+	return &Empty{}, nil
+}
+
+func (a *AdminServer) SetMaintenanceMode(ctx context.Context, in *SetMaintenanceModeRequest) (*Empty, error) {
+	a.server.setMaintenanceMode(ctx, in.Enabled, strings.TrimSpace(in.Message))
+	return &Empty{}, nil
+}
@@ -0,0 +1,75 @@
+package server2
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (a *AdminServer) BanPlayer(ctx context.Context, in *BanPlayerRequest) (*Empty, error) {
+	s := a.server
+
+	player, exists := s.players.get(in.PlayerId)
+	if !exists {
+		return nil, status.Error(codes.NotFound, "player not found")
+	}
+
+	if player.Guest {
+		return nil, status.Error(codes.FailedPrecondition, "guests can not be banned, kick them instead")
+	}
+
+	if in.DurationSeconds < 0 {
+		return nil, status.Error(codes.InvalidArgument, "ban duration can not be negative")
+	}
+
+	s.banPlayer(ctx, player, in.Reason, time.Duration(in.DurationSeconds)*time.Second)
+
+	return &Empty{}, nil
+}
+
+func (a *AdminServer) UnbanPlayer(ctx context.Context, in *UnbanPlayerRequest) (*Empty, error) {
+	s := a.server
+
+	player, exists := s.players.get(in.PlayerId)
+	if !exists {
+		return nil, status.Error(codes.NotFound, "player not found")
+	}
+
+	if !s.liftPlayerBan(player, time.Now()) {
+		return nil, status.Error(codes.FailedPrecondition, "player is not banned")
+	}
+
+	return &Empty{}, nil
+}
+
+func (a *AdminServer) GetPlayerBans(ctx context.Context, in *GetPlayerBansRequest) (*GetPlayerBansReply, error) {
+	s := a.server
+
+	player, exists := s.players.get(in.PlayerId)
+	if !exists {
+		return nil, status.Error(codes.NotFound, "player not found")
+	}
+
+	now := time.Now()
+	reply := &GetPlayerBansReply{Bans: make([]*AdminBan, 0, len(player.Bans))}
+	for _, ban := range player.Bans {
+		reply.Bans = append(reply.Bans, &AdminBan{
+			Reason:        ban.Reason,
+			BannedAtUnix:  ban.BannedAt.Unix(),
+			ExpiresAtUnix: unixOrZero(ban.ExpiresAt),
+			LiftedAtUnix:  unixOrZero(ban.LiftedAt),
+			Active:        ban.ActiveAt(now),
+		})
+	}
+
+	return reply, nil
+}
+
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
@@ -0,0 +1,22 @@
+package server2
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (a *AdminServer) BroadcastMessage(ctx context.Context, in *BroadcastMessageRequest) (*BroadcastMessageReply, error) {
+	s := a.server
+
+	message := strings.TrimSpace(in.Message)
+	if message == "" {
+		return nil, status.Error(codes.InvalidArgument, "message can not be empty")
+	}
+
+	recipients := s.queueServerUpdatesToAllClients(ctx, s.createPlayerClientUpdate(message))
+
+	return &BroadcastMessageReply{Recipients: int32(recipients)}, nil
+}
@@ -0,0 +1,74 @@
+package server2
+
+import (
+	"context"
+	"txtcto/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (a *AdminServer) CloseLobby(ctx context.Context, in *CloseLobbyRequest) (*Empty, error) {
+	s := a.server
+
+	lobby, exists := s.lobbies.get(in.LobbyId)
+	if !exists {
+		return nil, status.Error(codes.NotFound, "lobby not found")
+	}
+
+	if game, exists := s.games.get(lobby.GameId); exists && !game.Ended() {
+		return nil, status.Error(codes.FailedPrecondition, "lobby has a game in progress, end it first")
+	}
+
+	s.closeLobby(ctx, lobby, operatorMessage("Your lobby was closed by an operator", in.Reason))
+
+	return &Empty{}, nil
+}
+
+// closeLobby sends every member home with the message, withdraws the
+// invitations still pending for the lobby and forgets it.
+func (s *Server) closeLobby(ctx context.Context, lobby *models.Lobby, message string) {
+	members := make([]*models.Player, 0, len(lobby.Players))
+	for _, member := range lobby.Players {
+		members = append(members, member)
+	}
+
+	for _, member := range members {
+		s.removePlayerFromLobby(ctx, member, lobby)
+		if clientId, exists := s.playerClient.get(member.Id); exists {
+			s.queueServerUpdatesAndSignal(ctx, clientId,
+				s.createNavigationUpdate(NavigationPath_HOME),
+				s.createPlayerClientUpdate(message),
+			)
+		}
+	}
+
+	invitations := []*models.LobbyInvitation{}
+	s.lobbyInvitations.forEach(func(key string, invitation *models.LobbyInvitation) bool {
+		if invitation.Lobby.Id == lobby.Id && invitation.Status == models.InvitationStatus_PENDING {
+			invitations = append(invitations, invitation)
+		}
+		return true
+	})
+	s.lobbyInvitationsMu.Lock()
+	for _, invitation := range invitations {
+		if !s.closeLobbyInvitation(invitation, models.InvitationStatus_WITHDRAWN) {
+			continue
+		}
+		if clientId, exists := s.playerClient.get(invitation.Invitee.Id); exists {
+			s.queueServerUpdatesAndSignal(ctx, clientId, s.createLobbyInvitationResponseUpdate(invitation))
+		}
+	}
+	s.lobbyInvitationsMu.Unlock()
+
+	s.lobbies.delete(lobby.Id)
+}
+
+// operatorMessage appends the reason an operator gave, if any, to the
+// message shown to players.
+func operatorMessage(message, reason string) string {
+	if reason == "" {
+		return message
+	}
+	return message + ": " + reason
+}
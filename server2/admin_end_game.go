@@ -0,0 +1,77 @@
+package server2
+
+import (
+	"context"
+	"txtcto/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (a *AdminServer) EndGame(ctx context.Context, in *EndGameRequest) (*Empty, error) {
+	s := a.server
+
+	game, exists := s.games.get(in.GameId)
+	if !exists {
+		return nil, status.Error(codes.NotFound, "game not found")
+	}
+
+	if game.Ended() {
+		return nil, status.Error(codes.FailedPrecondition, "game has already ended")
+	}
+
+	switch in.Result {
+	case ForcedGameResult_FORCED_DRAW:
+		game.Result = models.GameResult_DRAW
+		game.Winner = nil
+	case ForcedGameResult_FORCED_WIN:
+		var winner *models.Player
+		for _, mover := range []*models.Player{game.MoverX, game.MoverO} {
+			if mover != nil && mover.Id == in.WinnerId {
+				winner = mover
+			}
+		}
+		if winner == nil {
+			return nil, status.Error(codes.InvalidArgument, "winner is not a game participant")
+		}
+		game.Result = models.GameResult_WIN
+		game.Winner = winner
+	default:
+		return nil, status.Error(codes.InvalidArgument, "unknown game result")
+	}
+
+	s.endGameByOperator(ctx, game)
+
+	return &Empty{}, nil
+}
+
+// endGameByOperator tells both players how the game ended and moves them on.
+// A forced end never offers a rematch.
+func (s *Server) endGameByOperator(ctx context.Context, game *models.Game) {
+	movers := []*models.Player{game.MoverX, game.MoverO}
+
+	for _, mover := range movers {
+		clientId, exists := s.playerClient.get(mover.Id)
+		if !exists {
+			continue
+		}
+
+		result := s.createDrawUpdate()
+		if game.Result == models.GameResult_WIN {
+			result = s.createWinnerUpdate(game.Winner.Id == mover.Id, Technicality_NO_PROBLEM)
+		}
+		s.queueServerUpdatesAndSignal(ctx, clientId, result, s.createPlayerClientUpdate("Your game was ended by an operator"))
+	}
+
+	if s.completeManagedGame(ctx, game) {
+		return
+	}
+
+	for _, mover := range movers {
+		s.playerGame.delete(mover.Id)
+		s.refreshPresence(ctx, mover.Id)
+		if clientId, exists := s.playerClient.get(mover.Id); exists {
+			s.queueServerUpdatesAndSignal(ctx, clientId, s.initialServerUpdates(ctx, clientId)...)
+		}
+	}
+}
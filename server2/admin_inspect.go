@@ -0,0 +1,147 @@
+package server2
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+	"txtcto/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (a *AdminServer) ListClients(ctx context.Context, in *ListClientsRequest) (*ListClientsReply, error) {
+	s := a.server
+
+	clients := []*AdminClient{}
+	s.clients.forEach(func(clientId string, client *models.Client) bool {
+		clients = append(clients, &AdminClient{Id: clientId})
+		return true
+	})
+
+	for _, client := range clients {
+		client.PlayerId, _ = s.clientPlayer.get(client.Id)
+		_, client.Connected = s.clientSignal.get(client.Id)
+		if lastSeen, exists := s.clientLastSeen.get(client.Id); exists {
+			client.LastSeenUnix = lastSeen.Unix()
+		}
+		client.PendingUpdates = int32(s.pendingServerUpdates(client.Id))
+	}
+
+	slices.SortFunc(clients, func(x, y *AdminClient) int {
+		return strings.Compare(x.Id, y.Id)
+	})
+
+	return &ListClientsReply{Clients: clients}, nil
+}
+
+func (a *AdminServer) ListPlayers(ctx context.Context, in *ListPlayersRequest) (*ListPlayersReply, error) {
+	s := a.server
+
+	players := []*models.Player{}
+	s.players.forEach(func(playerId string, player *models.Player) bool {
+		players = append(players, player)
+		return true
+	})
+
+	reply := &ListPlayersReply{Players: make([]*AdminPlayer, 0, len(players))}
+	for _, player := range players {
+		reply.Players = append(reply.Players, s.toAdminPlayer(player))
+	}
+
+	slices.SortFunc(reply.Players, func(x, y *AdminPlayer) int {
+		return strings.Compare(x.Id, y.Id)
+	})
+
+	return reply, nil
+}
+
+func (a *AdminServer) GetGame(ctx context.Context, in *GetGameRequest) (*AdminGame, error) {
+	game, exists := a.server.games.get(in.GameId)
+	if !exists {
+		return nil, status.Error(codes.NotFound, "game not found")
+	}
+	return toAdminGame(game), nil
+}
+
+func (a *AdminServer) GetLobby(ctx context.Context, in *GetLobbyRequest) (*AdminLobby, error) {
+	lobby, exists := a.server.lobbies.get(in.LobbyId)
+	if !exists {
+		return nil, status.Error(codes.NotFound, "lobby not found")
+	}
+	return toAdminLobby(lobby), nil
+}
+
+// pendingServerUpdates counts the updates queued for the client that have not
+// been sent yet.
+func (s *Server) pendingServerUpdates(clientId string) int {
+	updates, _ := s.clientServerUpdates.get(clientId)
+	lastIndex, exists := s.clientLastIndexServerUpdate.get(clientId)
+	if !exists {
+		lastIndex = -1
+	}
+	return max(len(updates)-lastIndex-1, 0)
+}
+
+func (s *Server) toAdminPlayer(player *models.Player) *AdminPlayer {
+	adminPlayer := &AdminPlayer{
+		Id:          player.Id,
+		Name:        player.Name,
+		DisplayName: player.DisplayName,
+		Guest:       player.Guest,
+		Presence:    s.getPresence(player.Id),
+	}
+	adminPlayer.ClientId, _ = s.playerClient.get(player.Id)
+	adminPlayer.LobbyId, _ = s.playerLobby.get(player.Id)
+	adminPlayer.GameId, _ = s.playerGame.get(player.Id)
+	if ban, banned := player.ActiveBan(time.Now()); banned {
+		adminPlayer.Banned = true
+		adminPlayer.BanReason = ban.Reason
+		adminPlayer.BanExpiresUnix = unixOrZero(ban.ExpiresAt)
+	}
+	return adminPlayer
+}
+
+func toAdminGame(game *models.Game) *AdminGame {
+	adminGame := &AdminGame{
+		Id:           game.Id,
+		Board:        slices.Clone(game.Board[:]),
+		Result:       AdminGameResult(game.Result),
+		LobbyId:      game.LobbyId,
+		TournamentId: game.TournamentId,
+		SeriesId:     game.SeriesId,
+	}
+	if game.MoverX != nil {
+		adminGame.MoverXId = game.MoverX.Id
+	}
+	if game.MoverO != nil {
+		adminGame.MoverOId = game.MoverO.Id
+	}
+	if game.Mover != nil {
+		adminGame.MoverId = game.Mover.Id
+	}
+	if game.Winner != nil {
+		adminGame.WinnerId = game.Winner.Id
+	}
+	return adminGame
+}
+
+func toAdminLobby(lobby *models.Lobby) *AdminLobby {
+	adminLobby := &AdminLobby{
+		Id:        lobby.Id,
+		Name:      lobby.Name,
+		PlayQueue: lobby.PlayQueue(),
+		Mode:      LobbyMode(lobby.Mode),
+		GameId:    lobby.GameId,
+	}
+	if lobby.Creator != nil {
+		adminLobby.HostId = lobby.Creator.Id
+	}
+	for playerId := range lobby.Players {
+		adminLobby.PlayerIds = append(adminLobby.PlayerIds, playerId)
+	}
+	adminLobby.ReadyPlayerIds = lobby.ReadyPlayerIds()
+	slices.Sort(adminLobby.PlayerIds)
+	return adminLobby
+}
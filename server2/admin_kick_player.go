@@ -0,0 +1,30 @@
+package server2
+
+import (
+	"context"
+	"txtcto/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (a *AdminServer) KickPlayer(ctx context.Context, in *KickPlayerRequest) (*Empty, error) {
+	s := a.server
+
+	player, exists := s.players.get(in.PlayerId)
+	if !exists {
+		return nil, status.Error(codes.NotFound, "player not found")
+	}
+
+	s.kickPlayer(ctx, player, operatorMessage("You were signed out by an operator", in.Reason))
+
+	return &Empty{}, nil
+}
+
+// kickPlayer signs the player out of every client. Games, lobbies and
+// tournaments are left alone, so the player can sign back in and carry on.
+func (s *Server) kickPlayer(ctx context.Context, player *models.Player, message string) {
+	s.signOutOtherClients(ctx, player.Id, "", message)
+	s.playerClient.delete(player.Id)
+	s.refreshPresence(ctx, player.Id)
+}
@@ -0,0 +1,184 @@
+package server2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+	"txtcto/models"
+
+	"github.com/google/uuid"
+)
+
+// createAnnouncement records a new announcement and shows it to every client
+// straight away. A zero duration keeps it until it is withdrawn.
+func (s *Server) createAnnouncement(ctx context.Context, message string, severity models.AnnouncementSeverity, duration time.Duration) *models.Announcement {
+	now := time.Now()
+	announcement := &models.Announcement{
+		Id:        uuid.New().String(),
+		Message:   message,
+		Severity:  severity,
+		CreatedAt: now,
+	}
+	if duration > 0 {
+		announcement.ExpiresAt = now.Add(duration)
+	}
+
+	s.announcements.set(announcement.Id, announcement)
+	s.saveAnnouncements()
+
+	s.queueServerUpdatesToAllClients(ctx, s.createSystemAnnouncementUpdate(announcement, false))
+
+	return announcement
+}
+
+// withdrawAnnouncement removes the announcement and tells every client to
+// stop showing it.
+func (s *Server) withdrawAnnouncement(ctx context.Context, announcementId string) bool {
+	announcement, exists := s.announcements.get(announcementId)
+	if !exists {
+		return false
+	}
+
+	s.announcements.delete(announcementId)
+	s.saveAnnouncements()
+
+	s.queueServerUpdatesToAllClients(ctx, s.createSystemAnnouncementUpdate(announcement, true))
+
+	return true
+}
+
+// activeAnnouncements returns the announcements still being shown, oldest
+// first.
+func (s *Server) activeAnnouncements(now time.Time) []*models.Announcement {
+	announcements := []*models.Announcement{}
+	s.announcements.forEach(func(announcementId string, announcement *models.Announcement) bool {
+		if announcement.ActiveAt(now) {
+			announcements = append(announcements, announcement)
+		}
+		return true
+	})
+
+	slices.SortFunc(announcements, func(a, b *models.Announcement) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	return announcements
+}
+
+func (s *Server) getAnnouncementInitialUpdates() []*ServerUpdate {
+	updates := []*ServerUpdate{}
+	for _, announcement := range s.activeAnnouncements(time.Now()) {
+		updates = append(updates, s.createSystemAnnouncementUpdate(announcement, false))
+	}
+	return updates
+}
+
+func (s *Server) queueServerUpdatesToAllClients(ctx context.Context, updates ...*ServerUpdate) int {
+	clientIds := []string{}
+	s.clients.forEach(func(clientId string, client *models.Client) bool {
+		clientIds = append(clientIds, clientId)
+		return true
+	})
+
+	for _, clientId := range clientIds {
+		s.queueServerUpdatesAndSignal(ctx, clientId, updates...)
+	}
+
+	return len(clientIds)
+}
+
+// sweepAnnouncements forgets announcements that have expired. Clients hide
+// them on their own once the expiry passes.
+func (s *Server) sweepAnnouncements(now time.Time) int {
+	expired := []string{}
+	s.announcements.forEach(func(announcementId string, announcement *models.Announcement) bool {
+		if !announcement.ActiveAt(now) {
+			expired = append(expired, announcementId)
+		}
+		return true
+	})
+
+	for _, announcementId := range expired {
+		s.announcements.delete(announcementId)
+	}
+
+	if len(expired) > 0 {
+		s.saveAnnouncements()
+	}
+
+	return len(expired)
+}
+
+// loadAnnouncements reads the announcements saved by a previous run. A
+// missing file just means there are none yet.
+func (s *Server) loadAnnouncements() {
+	if s.announcementsFile == "" {
+		return
+	}
+
+	data, err := os.ReadFile(s.announcementsFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		return
+	}
+	if err != nil {
+		s.logger.Error("announcements: unable to read", slog.String("file", s.announcementsFile), slog.Any("error", err))
+		return
+	}
+
+	var announcements []*models.Announcement
+	if err := json.Unmarshal(data, &announcements); err != nil {
+		s.logger.Error("announcements: unable to parse", slog.String("file", s.announcementsFile), slog.Any("error", err))
+		return
+	}
+
+	now := time.Now()
+	for _, announcement := range announcements {
+		if announcement.ActiveAt(now) {
+			s.announcements.set(announcement.Id, announcement)
+		}
+	}
+}
+
+// saveAnnouncements writes the active announcements to the announcements
+// file, if there is one, replacing it atomically.
+func (s *Server) saveAnnouncements() {
+	if s.announcementsFile == "" {
+		return
+	}
+
+	s.announcementsMu.Lock()
+	defer s.announcementsMu.Unlock()
+
+	data, err := json.MarshalIndent(s.activeAnnouncements(time.Now()), "", "  ")
+	if err != nil {
+		s.logger.Error("announcements: unable to encode", slog.Any("error", err))
+		return
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.announcementsFile), filepath.Base(s.announcementsFile)+".*")
+	if err != nil {
+		s.logger.Error("announcements: unable to save", slog.String("file", s.announcementsFile), slog.Any("error", err))
+		return
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		s.logger.Error("announcements: unable to save", slog.String("file", s.announcementsFile), slog.Any("error", err))
+		return
+	}
+	if err := tmp.Close(); err != nil {
+		s.logger.Error("announcements: unable to save", slog.String("file", s.announcementsFile), slog.Any("error", err))
+		return
+	}
+
+	if err := os.Rename(tmp.Name(), s.announcementsFile); err != nil {
+		s.logger.Error("announcements: unable to save", slog.String("file", s.announcementsFile), slog.Any("error", err))
+	}
+}
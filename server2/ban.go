@@ -0,0 +1,109 @@
+package server2
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"txtcto/models"
+
+	"google.golang.org/grpc/codes"
+)
+
+// checkPlayerBan refuses players with an active ban or suspension and tells
+// them why and for how long.
+func (s *Server) checkPlayerBan(player *models.Player) *Outcome {
+	ban, banned := player.ActiveBan(time.Now())
+	if !banned {
+		return &Outcome{Ok: true}
+	}
+
+	return &Outcome{
+		Ok:           false,
+		ErrorCode:    int32(codes.PermissionDenied),
+		ErrorMessage: banMessage(ban),
+	}
+}
+
+func banMessage(ban *models.Ban) string {
+	if ban.Permanent() {
+		return operatorMessage("Your account is banned", ban.Reason)
+	}
+	return operatorMessage(fmt.Sprintf("Your account is suspended until %s", ban.ExpiresAt.UTC().Format(time.RFC1123)), ban.Reason)
+}
+
+// enforcePlayerBan signs the client out if it is signed in as a banned
+// player and reports whether it did.
+func (s *Server) enforcePlayerBan(ctx context.Context, clientId string) bool {
+	playerId, exists := s.clientPlayer.get(clientId)
+	if !exists {
+		return false
+	}
+
+	player, exists := s.players.get(playerId)
+	if !exists {
+		return false
+	}
+
+	outcome := s.checkPlayerBan(player)
+	if outcome.Ok {
+		return false
+	}
+
+	s.signOutBannedClient(ctx, clientId, player, outcome.ErrorMessage)
+	return true
+}
+
+func (s *Server) signOutBannedClient(ctx context.Context, clientId string, player *models.Player, message string) {
+	s.clientPlayer.delete(clientId)
+	if playerClientId, exists := s.playerClient.get(player.Id); exists && playerClientId == clientId {
+		s.playerClient.delete(player.Id)
+	}
+	s.refreshPresence(ctx, player.Id)
+
+	s.queueServerUpdatesAndSignal(ctx, clientId,
+		s.createNavigationUpdate(NavigationPath_WELCOME),
+		s.createPlayerDisplayNameUpdate(""),
+		s.createPlayerClientUpdate(message),
+	)
+}
+
+// banPlayer bans the player for the duration, or until lifted when it is
+// zero, replacing any ban already in place. The player is taken out of
+// everything they are playing in and signed out everywhere.
+func (s *Server) banPlayer(ctx context.Context, player *models.Player, reason string, duration time.Duration) *models.Ban {
+	now := time.Now()
+
+	s.liftPlayerBan(player, now)
+
+	ban := &models.Ban{
+		Reason:   reason,
+		BannedAt: now,
+	}
+	if duration > 0 {
+		ban.ExpiresAt = now.Add(duration)
+	}
+	player.Bans = append(player.Bans, ban)
+
+	if lobby, outcome := s.getPlayerLobby(player.Id); outcome.Ok {
+		s.removePlayerFromLobby(ctx, player, lobby)
+	}
+	s.declinePlayerRematch(ctx, player)
+	s.forfeitActiveGame(ctx, player)
+	s.withdrawFromTournaments(ctx, player)
+	s.withdrawPlayerInvitations(ctx, player)
+
+	s.kickPlayer(ctx, player, banMessage(ban))
+
+	return ban
+}
+
+// liftPlayerBan ends the player's active ban, if any, and reports whether
+// there was one.
+func (s *Server) liftPlayerBan(player *models.Player, now time.Time) bool {
+	ban, banned := player.ActiveBan(now)
+	if !banned {
+		return false
+	}
+	ban.LiftedAt = now
+	return true
+}
@@ -0,0 +1,110 @@
+package server2
+
+import (
+	"context"
+	"txtcto/models"
+
+	"google.golang.org/grpc/codes"
+)
+
+func (s *Server) blockPlayer(ctx context.Context, clientId string, in *BlockPlayerRequest) error {
+	player, outcome := s.validatePlayer(ctx, clientId)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createBlockPlayerReply(outcome))
+		return nil
+	}
+
+	other, exists := s.players.get(in.PlayerId)
+	if !exists {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createBlockPlayerReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.NotFound),
+			ErrorMessage: "player not found",
+		}))
+		return nil
+	}
+
+	if other.Id == player.Id {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createBlockPlayerReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.InvalidArgument),
+			ErrorMessage: "you can not block yourself",
+		}))
+		return nil
+	}
+
+	player.Block(other.Id)
+	other.RemoveFriend(player.Id)
+
+	challenges := []*models.Challenge{}
+	s.challenges.forEach(func(key string, challenge *models.Challenge) bool {
+		if s.isBlocked(challenge.Challenger.Id, challenge.Challengee.Id) {
+			challenges = append(challenges, challenge)
+		}
+		return true
+	})
+	for _, challenge := range challenges {
+		s.closeChallenge(ctx, challenge, models.InvitationStatus_WITHDRAWN)
+	}
+
+	invitations := []*models.LobbyInvitation{}
+	s.lobbyInvitations.forEach(func(key string, invitation *models.LobbyInvitation) bool {
+		if invitation.Status == models.InvitationStatus_PENDING &&
+			((invitation.Inviter.Id == player.Id && invitation.Invitee.Id == other.Id) ||
+				(invitation.Inviter.Id == other.Id && invitation.Invitee.Id == player.Id)) {
+			invitations = append(invitations, invitation)
+		}
+		return true
+	})
+	s.lobbyInvitationsMu.Lock()
+	for _, invitation := range invitations {
+		if !s.closeLobbyInvitation(invitation, models.InvitationStatus_WITHDRAWN) {
+			continue
+		}
+
+		update := s.createLobbyInvitationResponseUpdate(invitation)
+		for _, recipient := range []*models.Player{invitation.Inviter, invitation.Invitee} {
+			if recipientClientId, exists := s.playerClient.get(recipient.Id); exists {
+				s.queueServerUpdatesAndSignal(ctx, recipientClientId, update)
+			}
+		}
+	}
+	s.lobbyInvitationsMu.Unlock()
+
+	s.queueServerUpdatesAndSignal(ctx, clientId,
+		s.createBlockPlayerReply(&Outcome{Ok: true}),
+		s.createBlockListUpdate(player),
+	)
+	s.queueFriendListUpdate(ctx, player, other)
+
+	return nil
+}
+
+// isBlocked reports whether either player has blocked the other.
+func (s *Server) isBlocked(playerId, otherId string) bool {
+	if player, exists := s.players.get(playerId); exists && player.Blocked[otherId] {
+		return true
+	}
+	if other, exists := s.players.get(otherId); exists && other.Blocked[playerId] {
+		return true
+	}
+	return false
+}
+
+func (s *Server) validateNotBlocked(player, other *models.Player) *Outcome {
+	if player.Blocked[other.Id] {
+		return &Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.FailedPrecondition),
+			ErrorMessage: "you have blocked this player",
+		}
+	}
+	if other.Blocked[player.Id] {
+		return &Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.PermissionDenied),
+			ErrorMessage: "player is not available",
+		}
+	}
+	return &Outcome{Ok: true}
+}
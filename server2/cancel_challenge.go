@@ -0,0 +1,31 @@
+package server2
+
+import (
+	"context"
+	"txtcto/models"
+
+	"google.golang.org/grpc/codes"
+)
+
+func (s *Server) cancelChallenge(ctx context.Context, clientId string, in *CancelChallengeRequest) error {
+	challenger, outcome := s.validatePlayer(ctx, clientId)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createCancelChallengeReply(outcome))
+		return nil
+	}
+
+	challenge, exists := s.challenges.get(in.ChallengeId)
+	if !exists || challenge.Challenger.Id != challenger.Id {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createCancelChallengeReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.NotFound),
+			ErrorMessage: "challenge not found",
+		}))
+		return nil
+	}
+
+	s.closeChallenge(ctx, challenge, models.InvitationStatus_WITHDRAWN)
+	s.queueServerUpdatesAndSignal(ctx, clientId, s.createCancelChallengeReply(&Outcome{Ok: true}))
+
+	return nil
+}
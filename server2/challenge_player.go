@@ -0,0 +1,155 @@
+package server2
+
+import (
+	"context"
+	"time"
+	"txtcto/models"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+)
+
+const challengeTTL = time.Minute
+
+func (s *Server) challengePlayer(ctx context.Context, clientId string, in *ChallengePlayerRequest) error {
+	challenger, outcome := s.validatePlayer(ctx, clientId)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createChallengePlayerReply(outcome))
+		return nil
+	}
+
+	if outcome := s.checkMaintenance(); !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createChallengePlayerReply(outcome))
+		return nil
+	}
+
+	if outcome := s.validateBestOf(in.BestOf); !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createChallengePlayerReply(outcome))
+		return nil
+	}
+
+	challengeeId, exists := s.playerNameId.get(in.PlayerName)
+	if !exists {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createChallengePlayerReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.NotFound),
+			ErrorMessage: "player with name not found",
+		}))
+		return nil
+	}
+
+	challengeeClientId, challengee, outcome := s.getClientIdAndPlayer(challengeeId, "challenged player")
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createChallengePlayerReply(outcome))
+		return nil
+	}
+
+	if challengee.Id == challenger.Id {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createChallengePlayerReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.InvalidArgument),
+			ErrorMessage: "you can not challenge yourself",
+		}))
+		return nil
+	}
+
+	if outcome := s.validateNotBlocked(challenger, challengee); !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createChallengePlayerReply(outcome))
+		return nil
+	}
+
+	if _, exists := s.playerGame.get(challenger.Id); exists {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createChallengePlayerReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.FailedPrecondition),
+			ErrorMessage: "you are currently in a game",
+		}))
+		return nil
+	}
+
+	if _, exists := s.playerGame.get(challengee.Id); exists {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createChallengePlayerReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.FailedPrecondition),
+			ErrorMessage: "challenged player is currently in a game",
+		}))
+		return nil
+	}
+
+	for _, challenge := range s.pendingChallenges(challengee.Id) {
+		if challenge.Challenger.Id == challenger.Id {
+			s.queueServerUpdatesAndSignal(ctx, clientId, s.createChallengePlayerReply(&Outcome{
+				Ok:           false,
+				ErrorCode:    int32(codes.AlreadyExists),
+				ErrorMessage: "player has already been challenged",
+			}))
+			return nil
+		}
+	}
+
+	challenge := &models.Challenge{
+		Id:         uuid.New().String(),
+		Challenger: challenger,
+		Challengee: challengee,
+		BestOf:     in.BestOf,
+		ExpiresAt:  time.Now().Add(challengeTTL),
+		Status:     models.InvitationStatus_PENDING,
+	}
+
+	s.challenges.set(challenge.Id, challenge)
+
+	time.AfterFunc(challengeTTL, func() {
+		s.closeChallenge(context.Background(), challenge, models.InvitationStatus_EXPIRED)
+	})
+
+	s.queueServerUpdatesAndSignal(ctx, clientId, s.createChallengePlayerReply(&Outcome{Ok: true}))
+	s.queueServerUpdatesAndSignal(ctx, challengeeClientId, s.createChallengeReceivedUpdate(challenge))
+
+	return nil
+}
+
+func (s *Server) pendingChallenges(challengeeId string) []*models.Challenge {
+	now := time.Now()
+	challenges := []*models.Challenge{}
+	s.challenges.forEach(func(key string, challenge *models.Challenge) bool {
+		if challenge.Challengee.Id == challengeeId && challenge.Status == models.InvitationStatus_PENDING && !challenge.Expired(now) {
+			challenges = append(challenges, challenge)
+		}
+		return true
+	})
+	return challenges
+}
+
+// closeChallenge settles a pending challenge with the given status and lets
+// both players know. It does nothing if the challenge was already settled;
+// the check and the new status happen under challengesMu, so only one of an
+// expiry, a cancellation and a response can settle it.
+func (s *Server) closeChallenge(ctx context.Context, challenge *models.Challenge, status models.InvitationStatus) bool {
+	s.challengesMu.Lock()
+	if _, exists := s.challenges.get(challenge.Id); !exists || challenge.Status != models.InvitationStatus_PENDING {
+		s.challengesMu.Unlock()
+		return false
+	}
+
+	challenge.Status = status
+	s.challenges.delete(challenge.Id)
+	s.challengesMu.Unlock()
+
+	update := s.createChallengeResponseUpdate(challenge)
+	for _, player := range []*models.Player{challenge.Challenger, challenge.Challengee} {
+		if clientId, exists := s.playerClient.get(player.Id); exists {
+			s.queueServerUpdatesAndSignal(ctx, clientId, update)
+		}
+	}
+
+	return true
+}
+
+func (s *Server) getChallengeInitialUpdates(playerId string) []*ServerUpdate {
+	challenges := s.pendingChallenges(playerId)
+	updates := make([]*ServerUpdate, 0, len(challenges))
+	for _, challenge := range challenges {
+		updates = append(updates, s.createChallengeReceivedUpdate(challenge))
+	}
+	return updates
+}
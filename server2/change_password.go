@@ -0,0 +1,71 @@
+package server2
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+)
+
+func (s *Server) changePassword(ctx context.Context, clientId string, in *ChangePasswordRequest) error {
+	player, outcome := s.validatePlayer(ctx, clientId)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createChangePasswordReply(outcome))
+		return nil
+	}
+
+	if outcome := s.validateNotGuest(player); !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createChangePasswordReply(outcome))
+		return nil
+	}
+
+	if player.Pass != in.CurrentPass {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createChangePasswordReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.PermissionDenied),
+			ErrorMessage: "player credentials not valid",
+		}))
+		return nil
+	}
+
+	if in.NewPass == "" {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createChangePasswordReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.InvalidArgument),
+			ErrorMessage: "new password can not be empty",
+		}))
+		return nil
+	}
+
+	player.Pass = in.NewPass
+	s.signOutOtherClients(ctx, player.Id, clientId, "Your password was changed")
+
+	s.queueServerUpdatesAndSignal(ctx, clientId, s.createChangePasswordReply(&Outcome{Ok: true}))
+
+	return nil
+}
+
+// signOutOtherClients detaches every client signed in as the player except
+// the one given and sends them back to the welcome screen.
+func (s *Server) signOutOtherClients(ctx context.Context, playerId, clientId, message string) {
+	clientIds := []string{}
+	s.clientPlayer.forEach(func(otherClientId string, otherPlayerId string) bool {
+		if otherPlayerId == playerId && otherClientId != clientId {
+			clientIds = append(clientIds, otherClientId)
+		}
+		return true
+	})
+
+	for _, otherClientId := range clientIds {
+		s.clientPlayer.delete(otherClientId)
+
+		s.queueServerUpdatesAndSignal(ctx, otherClientId,
+			s.createNavigationUpdate(NavigationPath_WELCOME),
+			s.createPlayerDisplayNameUpdate(""),
+			s.createPlayerClientUpdate(message),
+		)
+	}
+
+	if playerClientId, exists := s.playerClient.get(playerId); exists && playerClientId != clientId {
+		s.playerClient.set(playerId, clientId)
+	}
+}
@@ -1,33 +1,106 @@
 package server2
 
-func (s *Server) changePlayerDisplayName(clientId string, in *ChangePlayerDisplayNameRequest) error {
-	player, outcome := s.validatePlayer(clientId)
+import (
+	"context"
+	"fmt"
+	"time"
+	"txtcto/models"
+
+	"google.golang.org/grpc/codes"
+)
+
+func (s *Server) changePlayerDisplayName(ctx context.Context, clientId string, in *ChangePlayerDisplayNameRequest) error {
+	player, outcome := s.validatePlayer(ctx, clientId)
 	if !outcome.Ok {
-		s.queueServerUpdatesAndSignal(clientId, s.createChangePlayerDisplayNameReply(outcome))
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createChangePlayerDisplayNameReply(outcome))
 		return nil
 	}
 
-	if player.DisplayName == in.DisplayName {
-		s.queueServerUpdatesAndSignal(clientId, s.createChangePlayerDisplayNameReply(&Outcome{Ok: true}))
+	displayName, outcome := s.validateDisplayName(in.DisplayName)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createChangePlayerDisplayNameReply(outcome))
 		return nil
 	}
 
-	player.DisplayName = in.DisplayName
+	if player.DisplayName == displayName {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createChangePlayerDisplayNameReply(&Outcome{Ok: true}))
+		return nil
+	}
 
-	s.queueServerUpdatesAndSignal(clientId,
+	if s.displayNameCooldown > 0 && !player.DisplayNameChangedAt.IsZero() {
+		if wait := time.Until(player.DisplayNameChangedAt.Add(s.displayNameCooldown)); wait > 0 {
+			s.queueServerUpdatesAndSignal(ctx, clientId, s.createChangePlayerDisplayNameReply(&Outcome{
+				Ok:           false,
+				ErrorCode:    int32(codes.ResourceExhausted),
+				ErrorMessage: fmt.Sprintf("display name can be changed again in %s", wait.Round(time.Second)),
+			}))
+			return nil
+		}
+	}
+
+	s.accountsMu.Lock()
+	s.releaseDisplayName(player.Id, player.DisplayName)
+	if !s.claimDisplayName(player.Id, displayName) {
+		s.claimDisplayName(player.Id, player.DisplayName)
+		s.accountsMu.Unlock()
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createChangePlayerDisplayNameReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.AlreadyExists),
+			ErrorMessage: "display name is already taken",
+		}))
+		return nil
+	}
+	player.DisplayName = displayName
+	player.DisplayNameChangedAt = time.Now()
+	s.accountsMu.Unlock()
+
+	s.queueServerUpdatesAndSignal(ctx, clientId,
 		s.createChangePlayerDisplayNameReply(&Outcome{Ok: true}),
-		s.createPlayerDisplayNameUpdate(in.DisplayName),
+		s.createPlayerDisplayNameUpdate(displayName),
 	)
 
-	if lobbyId, exists := s.playerLobby.get(player.Id); exists {
-		if lobby, exists := s.lobbies.get(lobbyId); exists {
-			for _, lobbyPlayer := range lobby.Players {
-				if lobbyPlayerClientId, exists := s.playerClient.get(lobbyPlayer.Id); exists {
-					s.queueServerUpdatesAndSignal(lobbyPlayerClientId, s.createMyLobbyDetails(lobby))
+	s.propagateDisplayName(ctx, player)
+
+	return nil
+}
+
+// propagateDisplayName tells everyone who can currently see the player about
+// their new display name: lobby members, the opponent in an active game,
+// friends, and the entrants and spectators of the player's tournaments.
+func (s *Server) propagateDisplayName(ctx context.Context, player *models.Player) {
+	if lobby, outcome := s.getPlayerLobby(player.Id); outcome.Ok {
+		s.queueServerUpdatesToLobby(ctx, lobby, s.createMyLobbyDetails(lobby))
+	}
+
+	update := s.createPlayerRenamedUpdate(player)
+
+	if gameId, exists := s.playerGame.get(player.Id); exists {
+		if game, exists := s.games.get(gameId); exists {
+			for _, mover := range []*models.Player{game.MoverX, game.MoverO} {
+				if mover.Id == player.Id {
+					continue
+				}
+				if clientId, exists := s.playerClient.get(mover.Id); exists {
+					s.queueServerUpdatesAndSignal(ctx, clientId, update)
 				}
 			}
 		}
 	}
 
-	return nil
+	for friendId := range player.Friends {
+		if friend, exists := s.players.get(friendId); exists {
+			s.queueFriendListUpdate(ctx, friend)
+		}
+	}
+
+	tournaments := []*models.Tournament{}
+	s.tournaments.forEach(func(key string, tournament *models.Tournament) bool {
+		if _, exists := tournament.GetEntrant(player.Id); exists {
+			tournaments = append(tournaments, tournament)
+		}
+		return true
+	})
+	for _, tournament := range tournaments {
+		s.queueTournamentBracketUpdate(ctx, tournament)
+	}
 }
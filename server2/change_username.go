@@ -0,0 +1,61 @@
+package server2
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+)
+
+func (s *Server) changeUsername(ctx context.Context, clientId string, in *ChangeUsernameRequest) error {
+	player, outcome := s.validatePlayer(ctx, clientId)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createChangeUsernameReply(outcome))
+		return nil
+	}
+
+	if outcome := s.validateNotGuest(player); !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createChangeUsernameReply(outcome))
+		return nil
+	}
+
+	if player.Pass != in.Pass {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createChangeUsernameReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.PermissionDenied),
+			ErrorMessage: "player credentials not valid",
+		}))
+		return nil
+	}
+
+	if in.Name == "" {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createChangeUsernameReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.InvalidArgument),
+			ErrorMessage: "name can not be empty",
+		}))
+		return nil
+	}
+
+	s.accountsMu.Lock()
+	if playerId, exists := s.playerNameId.get(in.Name); exists && playerId != player.Id {
+		s.accountsMu.Unlock()
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createChangeUsernameReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.AlreadyExists),
+			ErrorMessage: "player with name already exists",
+		}))
+		return nil
+	}
+	s.playerNameId.delete(player.Name)
+	s.playerNameId.set(in.Name, player.Id)
+	player.Name = in.Name
+	s.accountsMu.Unlock()
+
+	s.queueServerUpdatesAndSignal(ctx, clientId, s.createChangeUsernameReply(&Outcome{Ok: true}))
+
+	if lobby, outcome := s.getPlayerLobby(player.Id); outcome.Ok {
+		s.queueServerUpdatesToLobby(ctx, lobby, s.createMyLobbyDetails(lobby))
+	}
+
+	return nil
+}
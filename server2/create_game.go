@@ -1,21 +1,81 @@
 package server2
 
 import (
+	"context"
 	"txtcto/models"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 )
 
-func (s *Server) createGame(clientId string, in *CreateGameRequest) error {
-	creator, outcome := s.validatePlayer(clientId)
+func (s *Server) createGame(ctx context.Context, clientId string, in *CreateGameRequest) error {
+	creator, outcome := s.validatePlayer(ctx, clientId)
 	if !outcome.Ok {
-		s.queueServerUpdatesAndSignal(clientId, s.createGameReply(outcome))
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createGameReply(outcome))
 		return nil
 	}
 
-	if _, exists := s.playerGame.get(in.Player1Id); exists {
-		s.queueServerUpdatesAndSignal(clientId, s.createGameReply(&Outcome{
+	if outcome := s.checkMaintenance(); !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createGameReply(outcome))
+		return nil
+	}
+
+	lobbyId, exists := s.playerLobby.get(creator.Id)
+	if !exists {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createGameReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.NotFound),
+			ErrorMessage: "player does not belong to any lobby",
+		}))
+		return nil
+	}
+
+	lobby, exists := s.lobbies.get(lobbyId)
+	if !exists {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createGameReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.NotFound),
+			ErrorMessage: "lobby does not exists",
+		}))
+		return nil
+	}
+
+	if lobby.Creator.Id != creator.Id {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createGameReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.PermissionDenied),
+			ErrorMessage: "only the lobby host can start a game",
+		}))
+		return nil
+	}
+
+	if lobby.Mode == models.LobbyMode_WINNER_STAYS_ON {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createGameReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.FailedPrecondition),
+			ErrorMessage: "games in this lobby are started from the play queue",
+		}))
+		return nil
+	}
+
+	if outcome := s.validateBestOf(in.BestOf); !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createGameReply(outcome))
+		return nil
+	}
+
+	player1Id, player2Id := s.pickReadyPlayers(lobby, in.Player1Id, in.Player2Id)
+
+	if player1Id == "" || player2Id == "" {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createGameReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.FailedPrecondition),
+			ErrorMessage: "not enough ready players in the lobby",
+		}))
+		return nil
+	}
+
+	if _, exists := s.playerGame.get(player1Id); exists {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createGameReply(&Outcome{
 			Ok:           false,
 			ErrorCode:    int32(codes.Internal),
 			ErrorMessage: "player 1 is currently in game",
@@ -23,8 +83,8 @@ func (s *Server) createGame(clientId string, in *CreateGameRequest) error {
 		return nil
 	}
 
-	if _, exists := s.playerGame.get(in.Player2Id); exists {
-		s.queueServerUpdatesAndSignal(clientId, s.createGameReply(&Outcome{
+	if _, exists := s.playerGame.get(player2Id); exists {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createGameReply(&Outcome{
 			Ok:           false,
 			ErrorCode:    int32(codes.Internal),
 			ErrorMessage: "player 2 is currently in game",
@@ -32,20 +92,20 @@ func (s *Server) createGame(clientId string, in *CreateGameRequest) error {
 		return nil
 	}
 
-	player1ClientId, player1, outcome := s.getClientIdAndPlayer(in.Player1Id, "player 1")
+	player1ClientId, player1, outcome := s.getClientIdAndPlayer(player1Id, "player 1")
 	if !outcome.Ok {
-		s.queueServerUpdatesAndSignal(clientId, s.createGameReply(outcome))
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createGameReply(outcome))
 		return nil
 	}
 
-	player2ClientId, player2, outcome := s.getClientIdAndPlayer(in.Player2Id, "player 2")
+	player2ClientId, player2, outcome := s.getClientIdAndPlayer(player2Id, "player 2")
 	if !outcome.Ok {
-		s.queueServerUpdatesAndSignal(clientId, s.createGameReply(outcome))
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createGameReply(outcome))
 		return nil
 	}
 
 	if player1.Id == player2.Id {
-		s.queueServerUpdatesAndSignal(clientId, s.createGameReply(&Outcome{
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createGameReply(&Outcome{
 			Ok:           false,
 			ErrorCode:    int32(codes.InvalidArgument),
 			ErrorMessage: "player 1 and player 2 is the same",
@@ -53,28 +113,84 @@ func (s *Server) createGame(clientId string, in *CreateGameRequest) error {
 		return nil
 	}
 
-	game, outcome := s.setupGame(creator, player1, player2)
+	if s.isBlocked(player1.Id, player2.Id) {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createGameReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.FailedPrecondition),
+			ErrorMessage: "player 1 and player 2 can not be paired",
+		}))
+		return nil
+	}
+
+	if _, exists := lobby.Players[player1.Id]; !exists {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createGameReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.FailedPrecondition),
+			ErrorMessage: "player 1 is not in the lobby",
+		}))
+		return nil
+	}
+
+	if _, exists := lobby.Players[player2.Id]; !exists {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createGameReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.FailedPrecondition),
+			ErrorMessage: "player 2 is not in the lobby",
+		}))
+		return nil
+	}
+
+	if !lobby.IsReady(player1.Id) {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createGameReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.FailedPrecondition),
+			ErrorMessage: "player 1 is not ready",
+		}))
+		return nil
+	}
+
+	if !lobby.IsReady(player2.Id) {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createGameReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.FailedPrecondition),
+			ErrorMessage: "player 2 is not ready",
+		}))
+		return nil
+	}
+
+	game, outcome := s.setupGame(ctx, creator, player1, player2)
 	if !outcome.Ok {
-		s.queueServerUpdatesAndSignal(clientId, s.createGameReply(outcome))
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createGameReply(outcome))
 		return nil
 	}
 
-	s.queueServerUpdatesAndSignal(clientId, s.createGameReply(&Outcome{Ok: true}))
-	s.queueServerUpdatesAndSignal(player1ClientId,
+	lobby.SetReady(player1.Id, false)
+	lobby.SetReady(player2.Id, false)
+	game.LobbyId = lobby.Id
+	lobby.GameId = game.Id
+
+	s.setupSeries(game, in.BestOf)
+
+	s.queueServerUpdatesToLobby(ctx, lobby, s.createLobbyReadyStateUpdate(lobby))
+
+	s.queueServerUpdatesAndSignal(ctx, clientId, s.createGameReply(&Outcome{Ok: true}))
+	s.queueServerUpdatesAndSignal(ctx, player1ClientId,
 		s.createNavigationUpdate(NavigationPath_GAME),
 		s.createGameStartUpdate(game, player1),
 		s.createNextMoverUpdate(s.areYouTheMover(game, player1)),
 	)
-	s.queueServerUpdatesAndSignal(player2ClientId,
+	s.queueServerUpdatesAndSignal(ctx, player1ClientId, s.getSeriesUpdates(game, player1)...)
+	s.queueServerUpdatesAndSignal(ctx, player2ClientId,
 		s.createNavigationUpdate(NavigationPath_GAME),
 		s.createGameStartUpdate(game, player2),
 		s.createNextMoverUpdate(s.areYouTheMover(game, player2)),
 	)
+	s.queueServerUpdatesAndSignal(ctx, player2ClientId, s.getSeriesUpdates(game, player2)...)
 
 	return nil
 }
 
-func (s *Server) setupGame(creator, player1, player2 *models.Player) (*models.Game, *Outcome) {
+func (s *Server) setupGame(ctx context.Context, creator, player1, player2 *models.Player) (*models.Game, *Outcome) {
 	gameId := uuid.New().String()
 
 	if _, exists := s.games.get(gameId); exists {
@@ -98,5 +214,42 @@ func (s *Server) setupGame(creator, player1, player2 *models.Player) (*models.Ga
 	s.playerGame.set(player2.Id, game.Id)
 	s.games.set(game.Id, game)
 
+	s.refreshPresence(ctx, player1.Id, player2.Id)
+
 	return game, &Outcome{Ok: true}
 }
+
+// discardGame undoes setupGame for a game that never started.
+func (s *Server) discardGame(ctx context.Context, game *models.Game) {
+	for _, player := range []*models.Player{game.MoverX, game.MoverO} {
+		if gameId, exists := s.playerGame.get(player.Id); exists && gameId == game.Id {
+			s.playerGame.delete(player.Id)
+		}
+	}
+	s.games.delete(game.Id)
+
+	s.refreshPresence(ctx, game.MoverX.Id, game.MoverO.Id)
+}
+
+func (s *Server) pickReadyPlayers(lobby *models.Lobby, player1Id, player2Id string) (string, string) {
+	for _, playerId := range lobby.ReadyPlayerIds() {
+		if player1Id != "" && player2Id != "" {
+			break
+		}
+		if playerId == player1Id || playerId == player2Id {
+			continue
+		}
+		if _, exists := s.playerGame.get(playerId); exists {
+			continue
+		}
+		if (player1Id != "" && s.isBlocked(player1Id, playerId)) || (player2Id != "" && s.isBlocked(player2Id, playerId)) {
+			continue
+		}
+		if player1Id == "" {
+			player1Id = playerId
+		} else {
+			player2Id = playerId
+		}
+	}
+	return player1Id, player2Id
+}
@@ -1,21 +1,22 @@
 package server2
 
 import (
+	"context"
 	"txtcto/models"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 )
 
-func (s *Server) createLobby(clientId string, in *CreateLobbyRequest) error {
-	player, outcome := s.validatePlayer(clientId)
+func (s *Server) createLobby(ctx context.Context, clientId string, in *CreateLobbyRequest) error {
+	player, outcome := s.validatePlayer(ctx, clientId)
 	if !outcome.Ok {
-		s.queueServerUpdatesAndSignal(clientId, s.createCreateLobbyReply(outcome))
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createCreateLobbyReply(outcome))
 		return nil
 	}
 
 	if _, exists := s.playerLobby.get(player.Id); exists {
-		s.queueServerUpdatesAndSignal(clientId, s.createCreateLobbyReply(&Outcome{
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createCreateLobbyReply(&Outcome{
 			Ok:           false,
 			ErrorCode:    int32(codes.AlreadyExists),
 			ErrorMessage: "player has already in a lobby",
@@ -24,7 +25,7 @@ func (s *Server) createLobby(clientId string, in *CreateLobbyRequest) error {
 
 	lobbyId := uuid.New().String()
 	if _, exists := s.lobbies.get(lobbyId); exists {
-		s.queueServerUpdatesAndSignal(clientId, s.createCreateLobbyReply(&Outcome{
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createCreateLobbyReply(&Outcome{
 			Ok:           false,
 			ErrorCode:    int32(codes.Internal),
 			ErrorMessage: "unable to create lobby",
@@ -42,11 +43,14 @@ func (s *Server) createLobby(clientId string, in *CreateLobbyRequest) error {
 
 	s.lobbies.set(lobby.Id, lobby)
 	s.playerLobby.set(player.Id, lobby.Id)
+	s.refreshPresence(ctx, player.Id)
 
-	s.queueServerUpdatesAndSignal(clientId,
+	s.queueServerUpdatesAndSignal(ctx, clientId,
 		s.createCreateLobbyReply(&Outcome{Ok: true}),
 		s.createNavigationUpdate(NavigationPath_MY_LOBBY),
 		s.createMyLobbyDetails(lobby),
+		s.createLobbyReadyStateUpdate(lobby),
+		s.createPlayQueueUpdate(lobby),
 	)
 
 	return nil
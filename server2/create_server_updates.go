@@ -1,6 +1,9 @@
 package server2
 
-import "txtcto/models"
+import (
+	"time"
+	"txtcto/models"
+)
 
 func (s *Server) createClientAssignmentUpdate(clientId string) *ServerUpdate {
 	return &ServerUpdate{
@@ -50,7 +53,13 @@ func (s *Server) createMyLobbyDetails(lobby *models.Lobby) *ServerUpdate {
 	return &ServerUpdate{
 		Type: &ServerUpdate_MyLobbyDetails{
 			MyLobbyDetails: &MyLobbyDetails{
-				Lobby: &Lobby{Id: lobby.Id, Name: lobby.Name, Players: players},
+				Lobby: &Lobby{
+					Id:      lobby.Id,
+					Name:    lobby.Name,
+					Players: players,
+					HostId:  lobby.Creator.Id,
+					Mode:    LobbyMode(lobby.Mode),
+				},
 			},
 		},
 	}
@@ -302,3 +311,520 @@ func (s *Server) createLobbySearchResult(list []*models.Lobby) *ServerUpdate {
 		},
 	}
 }
+
+func (s *Server) createInviteToLobbyReply(outcome *Outcome) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_InviteToLobbyReply{
+			InviteToLobbyReply: &InviteToLobbyReply{
+				Outcome: outcome,
+			},
+		},
+	}
+}
+
+func (s *Server) createLobbyInvitationUpdate(invitation *models.LobbyInvitation) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_LobbyInvitationUpdate{
+			LobbyInvitationUpdate: &LobbyInvitationUpdate{
+				Invitation: &LobbyInvitation{
+					Id:        invitation.Id,
+					Lobby:     &Lobby{Id: invitation.Lobby.Id, Name: invitation.Lobby.Name},
+					Inviter:   &Player{Id: invitation.Inviter.Id, Name: invitation.Inviter.DisplayName},
+					ExpiresAt: invitation.ExpiresAt.UnixMilli(),
+				},
+			},
+		},
+	}
+}
+
+func (s *Server) createRespondLobbyInvitationReply(outcome *Outcome) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_RespondLobbyInvitationReply{
+			RespondLobbyInvitationReply: &RespondLobbyInvitationReply{
+				Outcome: outcome,
+			},
+		},
+	}
+}
+
+func (s *Server) createLobbyInvitationResponseUpdate(invitation *models.LobbyInvitation) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_LobbyInvitationResponseUpdate{
+			LobbyInvitationResponseUpdate: &LobbyInvitationResponseUpdate{
+				InvitationId: invitation.Id,
+				Invitee:      &Player{Id: invitation.Invitee.Id, Name: invitation.Invitee.DisplayName},
+				Status:       InvitationStatus(invitation.Status),
+			},
+		},
+	}
+}
+
+func (s *Server) createSetReadyReply(outcome *Outcome) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_SetReadyReply{
+			SetReadyReply: &SetReadyReply{
+				Outcome: outcome,
+			},
+		},
+	}
+}
+
+func (s *Server) createLobbyReadyStateUpdate(lobby *models.Lobby) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_LobbyReadyStateUpdate{
+			LobbyReadyStateUpdate: &LobbyReadyStateUpdate{
+				ReadyPlayerIds: lobby.ReadyPlayerIds(),
+				GameId:         lobby.GameId,
+			},
+		},
+	}
+}
+
+func (s *Server) createSetLobbyModeReply(outcome *Outcome) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_SetLobbyModeReply{
+			SetLobbyModeReply: &SetLobbyModeReply{
+				Outcome: outcome,
+			},
+		},
+	}
+}
+
+func (s *Server) createJoinPlayQueueReply(outcome *Outcome) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_JoinPlayQueueReply{
+			JoinPlayQueueReply: &JoinPlayQueueReply{
+				Outcome: outcome,
+			},
+		},
+	}
+}
+
+func (s *Server) createLeavePlayQueueReply(outcome *Outcome) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_LeavePlayQueueReply{
+			LeavePlayQueueReply: &LeavePlayQueueReply{
+				Outcome: outcome,
+			},
+		},
+	}
+}
+
+func (s *Server) createPlayQueueUpdate(lobby *models.Lobby) *ServerUpdate {
+	playingPlayerIds := []string{}
+	if game, exists := s.getActiveLobbyGame(lobby); exists {
+		playingPlayerIds = append(playingPlayerIds, game.MoverX.Id, game.MoverO.Id)
+	}
+	return &ServerUpdate{
+		Type: &ServerUpdate_PlayQueueUpdate{
+			PlayQueueUpdate: &PlayQueueUpdate{
+				Mode:             LobbyMode(lobby.Mode),
+				QueuedPlayerIds:  lobby.PlayQueue(),
+				PlayingPlayerIds: playingPlayerIds,
+			},
+		},
+	}
+}
+
+func (s *Server) createCreateTournamentReply(outcome *Outcome) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_CreateTournamentReply{
+			CreateTournamentReply: &CreateTournamentReply{
+				Outcome: outcome,
+			},
+		},
+	}
+}
+
+func (s *Server) createJoinTournamentReply(outcome *Outcome) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_JoinTournamentReply{
+			JoinTournamentReply: &JoinTournamentReply{
+				Outcome: outcome,
+			},
+		},
+	}
+}
+
+func (s *Server) createLeaveTournamentReply(outcome *Outcome) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_LeaveTournamentReply{
+			LeaveTournamentReply: &LeaveTournamentReply{
+				Outcome: outcome,
+			},
+		},
+	}
+}
+
+func (s *Server) createWatchTournamentReply(outcome *Outcome) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_WatchTournamentReply{
+			WatchTournamentReply: &WatchTournamentReply{
+				Outcome: outcome,
+			},
+		},
+	}
+}
+
+func (s *Server) createGetTournamentReply(outcome *Outcome) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_GetTournamentReply{
+			GetTournamentReply: &GetTournamentReply{
+				Outcome: outcome,
+			},
+		},
+	}
+}
+
+func (s *Server) createTournamentBracketUpdate(tournament *models.Tournament) *ServerUpdate {
+	toPlayer := func(player *models.Player) *Player {
+		if player == nil {
+			return nil
+		}
+		return &Player{Id: player.Id, Name: player.DisplayName}
+	}
+
+	entrants := make([]*Player, 0, len(tournament.Entrants))
+	for _, entrant := range tournament.Entrants {
+		entrants = append(entrants, toPlayer(entrant.Player))
+	}
+
+	matches := make([]*TournamentMatch, 0, len(tournament.Matches))
+	for _, match := range tournament.Matches {
+		winnerId := ""
+		if match.Winner != nil {
+			winnerId = match.Winner.Id
+		}
+		matches = append(matches, &TournamentMatch{
+			Id:       match.Id,
+			Round:    match.Round,
+			Player1:  toPlayer(match.Player1),
+			Player2:  toPlayer(match.Player2),
+			GameId:   match.GameId,
+			WinnerId: winnerId,
+			Finished: match.Finished,
+		})
+	}
+
+	standings := []*TournamentStanding{}
+	if tournament.Status == models.TournamentStatus_IN_PROGRESS || tournament.Status == models.TournamentStatus_FINISHED {
+		for i, entrant := range s.getTournamentStandings(tournament) {
+			standings = append(standings, &TournamentStanding{
+				Rank:   int32(i + 1),
+				Player: toPlayer(entrant.Player),
+				Wins:   entrant.Wins,
+				Draws:  entrant.Draws,
+				Losses: entrant.Losses,
+				Points: entrant.Points(),
+			})
+		}
+	}
+
+	return &ServerUpdate{
+		Type: &ServerUpdate_TournamentBracketUpdate{
+			TournamentBracketUpdate: &TournamentBracketUpdate{
+				Tournament: &Tournament{
+					Id:                 tournament.Id,
+					Name:               tournament.Name,
+					Format:             TournamentFormat(tournament.Format),
+					Status:             TournamentStatus(tournament.Status),
+					RegistrationEndsAt: tournament.RegistrationEndsAt.UnixMilli(),
+					Entrants:           entrants,
+					Round:              tournament.Round,
+					Matches:            matches,
+					Standings:          standings,
+				},
+			},
+		},
+	}
+}
+
+func (s *Server) createSeriesScoreUpdate(series *models.Series, you *models.Player) *ServerUpdate {
+	yours, opponents := 0, 1
+	if series.PlayerIndex(you.Id) == 1 {
+		yours, opponents = 1, 0
+	}
+	winner := series.Winner()
+	return &ServerUpdate{
+		Type: &ServerUpdate_SeriesScoreUpdate{
+			SeriesScoreUpdate: &SeriesScoreUpdate{
+				SeriesId:     series.Id,
+				BestOf:       series.BestOf,
+				GamesPlayed:  series.GamesPlayed(),
+				YourWins:     series.Wins[yours],
+				OpponentWins: series.Wins[opponents],
+				Draws:        series.Draws,
+				Decided:      series.Decided(),
+				YouWon:       winner != nil && winner.Id == you.Id,
+			},
+		},
+	}
+}
+
+func (s *Server) createRematchCountdownUpdate(expiresAt time.Time) *ServerUpdate {
+	remaining := time.Until(expiresAt).Round(time.Second)
+	if remaining < 0 {
+		remaining = 0
+	}
+	return &ServerUpdate{
+		Type: &ServerUpdate_RematchCountdownUpdate{
+			RematchCountdownUpdate: &RematchCountdownUpdate{
+				ExpiresAt:        expiresAt.UnixMilli(),
+				SecondsRemaining: int32(remaining / time.Second),
+			},
+		},
+	}
+}
+
+func (s *Server) createAddFriendReply(outcome *Outcome) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_AddFriendReply{
+			AddFriendReply: &AddFriendReply{
+				Outcome: outcome,
+			},
+		},
+	}
+}
+
+func (s *Server) createRemoveFriendReply(outcome *Outcome) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_RemoveFriendReply{
+			RemoveFriendReply: &RemoveFriendReply{
+				Outcome: outcome,
+			},
+		},
+	}
+}
+
+func (s *Server) createRespondFriendReply(outcome *Outcome) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_RespondFriendReply{
+			RespondFriendReply: &RespondFriendReply{
+				Outcome: outcome,
+			},
+		},
+	}
+}
+
+func (s *Server) createFriendListUpdate(player *models.Player) *ServerUpdate {
+	toPlayers := func(ids map[string]bool) []*Player {
+		list := make([]*Player, 0, len(ids))
+		for id := range ids {
+			if other, exists := s.players.get(id); exists {
+				list = append(list, &Player{Id: other.Id, Name: other.DisplayName})
+			}
+		}
+		return list
+	}
+
+	friends := make([]*Friend, 0, len(player.Friends))
+	for friendId := range player.Friends {
+		if friend, exists := s.players.get(friendId); exists {
+			friends = append(friends, &Friend{
+				Player:   &Player{Id: friend.Id, Name: friend.DisplayName},
+				Presence: s.getPresence(friend.Id),
+			})
+		}
+	}
+
+	return &ServerUpdate{
+		Type: &ServerUpdate_FriendListUpdate{
+			FriendListUpdate: &FriendListUpdate{
+				Friends:          friends,
+				IncomingRequests: toPlayers(player.IncomingFriendRequests),
+				OutgoingRequests: toPlayers(player.OutgoingFriendRequests),
+			},
+		},
+	}
+}
+
+func (s *Server) createFriendPresenceUpdate(friend *models.Player, presence Presence) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_FriendPresenceUpdate{
+			FriendPresenceUpdate: &FriendPresenceUpdate{
+				Friend: &Friend{
+					Player:   &Player{Id: friend.Id, Name: friend.DisplayName},
+					Presence: presence,
+				},
+			},
+		},
+	}
+}
+
+func (s *Server) createChallengePlayerReply(outcome *Outcome) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_ChallengePlayerReply{
+			ChallengePlayerReply: &ChallengePlayerReply{
+				Outcome: outcome,
+			},
+		},
+	}
+}
+
+func (s *Server) createRespondChallengeReply(outcome *Outcome) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_RespondChallengeReply{
+			RespondChallengeReply: &RespondChallengeReply{
+				Outcome: outcome,
+			},
+		},
+	}
+}
+
+func (s *Server) createCancelChallengeReply(outcome *Outcome) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_CancelChallengeReply{
+			CancelChallengeReply: &CancelChallengeReply{
+				Outcome: outcome,
+			},
+		},
+	}
+}
+
+func (s *Server) toChallenge(challenge *models.Challenge) *Challenge {
+	return &Challenge{
+		Id:         challenge.Id,
+		Challenger: &Player{Id: challenge.Challenger.Id, Name: challenge.Challenger.DisplayName},
+		Challengee: &Player{Id: challenge.Challengee.Id, Name: challenge.Challengee.DisplayName},
+		BestOf:     challenge.BestOf,
+		ExpiresAt:  challenge.ExpiresAt.UnixMilli(),
+	}
+}
+
+func (s *Server) createChallengeReceivedUpdate(challenge *models.Challenge) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_ChallengeReceivedUpdate{
+			ChallengeReceivedUpdate: &ChallengeReceivedUpdate{
+				Challenge: s.toChallenge(challenge),
+			},
+		},
+	}
+}
+
+func (s *Server) createChallengeResponseUpdate(challenge *models.Challenge) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_ChallengeResponseUpdate{
+			ChallengeResponseUpdate: &ChallengeResponseUpdate{
+				Challenge: s.toChallenge(challenge),
+				Status:    InvitationStatus(challenge.Status),
+			},
+		},
+	}
+}
+
+func (s *Server) createBlockPlayerReply(outcome *Outcome) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_BlockPlayerReply{
+			BlockPlayerReply: &BlockPlayerReply{
+				Outcome: outcome,
+			},
+		},
+	}
+}
+
+func (s *Server) createUnblockPlayerReply(outcome *Outcome) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_UnblockPlayerReply{
+			UnblockPlayerReply: &UnblockPlayerReply{
+				Outcome: outcome,
+			},
+		},
+	}
+}
+
+func (s *Server) createBlockListUpdate(player *models.Player) *ServerUpdate {
+	players := make([]*Player, 0, len(player.Blocked))
+	for blockedId := range player.Blocked {
+		if blocked, exists := s.players.get(blockedId); exists {
+			players = append(players, &Player{Id: blocked.Id, Name: blocked.DisplayName})
+		}
+	}
+	return &ServerUpdate{
+		Type: &ServerUpdate_BlockListUpdate{
+			BlockListUpdate: &BlockListUpdate{
+				Players: players,
+			},
+		},
+	}
+}
+
+func (s *Server) createChangePasswordReply(outcome *Outcome) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_ChangePasswordReply{
+			ChangePasswordReply: &ChangePasswordReply{
+				Outcome: outcome,
+			},
+		},
+	}
+}
+
+func (s *Server) createChangeUsernameReply(outcome *Outcome) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_ChangeUsernameReply{
+			ChangeUsernameReply: &ChangeUsernameReply{
+				Outcome: outcome,
+			},
+		},
+	}
+}
+
+func (s *Server) createDeleteAccountReply(outcome *Outcome) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_DeleteAccountReply{
+			DeleteAccountReply: &DeleteAccountReply{
+				Outcome: outcome,
+			},
+		},
+	}
+}
+
+func (s *Server) createPlayerRenamedUpdate(player *models.Player) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_PlayerRenamedUpdate{
+			PlayerRenamedUpdate: &PlayerRenamedUpdate{
+				Player: &Player{Id: player.Id, Name: player.DisplayName},
+			},
+		},
+	}
+}
+
+func (s *Server) createPlayAsGuestReply(outcome *Outcome) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_PlayAsGuestReply{
+			PlayAsGuestReply: &PlayAsGuestReply{
+				Outcome: outcome,
+			},
+		},
+	}
+}
+
+func (s *Server) createRateLimitUpdate(outcome *Outcome) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_RateLimitUpdate{
+			RateLimitUpdate: &RateLimitUpdate{
+				Outcome: outcome,
+			},
+		},
+	}
+}
+
+func (s *Server) toAnnouncement(announcement *models.Announcement) *Announcement {
+	return &Announcement{
+		Id:            announcement.Id,
+		Message:       announcement.Message,
+		Severity:      AnnouncementSeverity(announcement.Severity),
+		CreatedAtUnix: announcement.CreatedAt.Unix(),
+		ExpiresAtUnix: unixOrZero(announcement.ExpiresAt),
+	}
+}
+
+func (s *Server) createSystemAnnouncementUpdate(announcement *models.Announcement, withdrawn bool) *ServerUpdate {
+	return &ServerUpdate{
+		Type: &ServerUpdate_SystemAnnouncementUpdate{
+			SystemAnnouncementUpdate: &SystemAnnouncementUpdate{
+				Announcement: s.toAnnouncement(announcement),
+				Withdrawn:    withdrawn,
+			},
+		},
+	}
+}
@@ -0,0 +1,98 @@
+package server2
+
+import (
+	"context"
+	"time"
+	"txtcto/models"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	defaultTournamentRegistration = time.Minute
+	minTournamentRegistration     = 10 * time.Second
+	maxTournamentRegistration     = time.Hour
+)
+
+func (s *Server) createTournament(ctx context.Context, clientId string, in *CreateTournamentRequest) error {
+	creator, outcome := s.validatePlayer(ctx, clientId)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createCreateTournamentReply(outcome))
+		return nil
+	}
+
+	if outcome := s.checkMaintenance(); !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createCreateTournamentReply(outcome))
+		return nil
+	}
+
+	if _, exists := TournamentFormat_name[int32(in.Format)]; !exists {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createCreateTournamentReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.InvalidArgument),
+			ErrorMessage: "tournament format is not supported",
+		}))
+		return nil
+	}
+
+	registration := defaultTournamentRegistration
+	if in.RegistrationSeconds != 0 {
+		registration = time.Duration(in.RegistrationSeconds) * time.Second
+	}
+
+	if registration < minTournamentRegistration || registration > maxTournamentRegistration {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createCreateTournamentReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.InvalidArgument),
+			ErrorMessage: "tournament registration window is out of range",
+		}))
+		return nil
+	}
+
+	tournamentId := uuid.New().String()
+	if _, exists := s.tournaments.get(tournamentId); exists {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createCreateTournamentReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.Internal),
+			ErrorMessage: "unable to create tournament",
+		}))
+		return nil
+	}
+
+	tournament := &models.Tournament{
+		Id:                 tournamentId,
+		Name:               in.Name,
+		Creator:            creator,
+		Format:             models.TournamentFormat(in.Format),
+		Status:             models.TournamentStatus_REGISTRATION,
+		RegistrationEndsAt: time.Now().Add(registration),
+		Entrants:           []*models.TournamentEntrant{},
+		Spectators:         map[string]bool{creator.Id: true},
+	}
+
+	s.tournaments.set(tournament.Id, tournament)
+
+	time.AfterFunc(registration, func() {
+		s.startTournament(context.Background(), tournament.Id)
+	})
+
+	s.queueServerUpdatesAndSignal(ctx, clientId,
+		s.createCreateTournamentReply(&Outcome{Ok: true}),
+		s.createTournamentBracketUpdate(tournament),
+	)
+
+	return nil
+}
+
+func (s *Server) getTournament(tournamentId string) (*models.Tournament, *Outcome) {
+	tournament, exists := s.tournaments.get(tournamentId)
+	if !exists {
+		return nil, &Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.NotFound),
+			ErrorMessage: "tournament does not exists",
+		}
+	}
+	return tournament, &Outcome{Ok: true}
+}
@@ -0,0 +1,242 @@
+package server2
+
+import (
+	"context"
+	"slices"
+	"txtcto/models"
+
+	"google.golang.org/grpc/codes"
+)
+
+const deletedPlayerDisplayName = "deleted player"
+
+func (s *Server) deleteAccount(ctx context.Context, clientId string, in *DeleteAccountRequest) error {
+	player, outcome := s.validatePlayer(ctx, clientId)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createDeleteAccountReply(outcome))
+		return nil
+	}
+
+	if outcome := s.validateNotGuest(player); !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createDeleteAccountReply(outcome))
+		return nil
+	}
+
+	if player.Pass != in.Pass {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createDeleteAccountReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.PermissionDenied),
+			ErrorMessage: "player credentials not valid",
+		}))
+		return nil
+	}
+
+	s.purgePlayer(ctx, player, clientId, "Your account was deleted")
+
+	s.queueServerUpdatesAndSignal(ctx, clientId,
+		s.createDeleteAccountReply(&Outcome{Ok: true}),
+		s.createPlayerDisplayNameUpdate(""),
+		s.createNavigationUpdate(NavigationPath_WELCOME),
+	)
+
+	return nil
+}
+
+// purgePlayer removes every trace of the player from the server. Any client
+// signed in as the player other than the given one is sent back to the
+// welcome screen with the message.
+func (s *Server) purgePlayer(ctx context.Context, player *models.Player, clientId, message string) {
+	if lobby, outcome := s.getPlayerLobby(player.Id); outcome.Ok {
+		s.removePlayerFromLobby(ctx, player, lobby)
+	}
+	s.declinePlayerRematch(ctx, player)
+	s.forfeitActiveGame(ctx, player)
+	s.withdrawFromTournaments(ctx, player)
+	s.withdrawPlayerInvitations(ctx, player)
+	s.removePlayerRelations(ctx, player)
+
+	s.accountsMu.Lock()
+	s.playerNameId.delete(player.Name)
+	s.releaseDisplayName(player.Id, player.DisplayName)
+	s.accountsMu.Unlock()
+
+	s.signOutOtherClients(ctx, player.Id, clientId, message)
+	s.clientPlayer.delete(clientId)
+	s.playerClient.delete(player.Id)
+	s.players.delete(player.Id)
+	s.playerPresence.delete(player.Id)
+	s.playerSearchingLobby.delete(player.Id)
+	s.playerLobbyInvitations.delete(player.Id)
+
+	// Finished games, series and tournament brackets keep pointing at the
+	// player, so the record is anonymized rather than dropped.
+	player.Name = ""
+	player.Pass = ""
+	player.DisplayName = deletedPlayerDisplayName
+	player.Friends = nil
+	player.IncomingFriendRequests = nil
+	player.OutgoingFriendRequests = nil
+	player.Blocked = nil
+}
+
+// declinePlayerRematch answers a pending rematch with no on behalf of the
+// player and sends the opponent back to where they came from.
+func (s *Server) declinePlayerRematch(ctx context.Context, player *models.Player) {
+	rematchId, exists := s.playerRematch.get(player.Id)
+	if !exists {
+		return
+	}
+
+	rematch, exists := s.rematches.get(rematchId)
+	if !exists {
+		s.playerRematch.delete(player.Id)
+		return
+	}
+
+	rematch.SetPlayerDecision(player.Id, models.Decision_NO)
+	_, updates := s.evaluateRematch(ctx, rematch)
+
+	for _, pd := range rematch.PlayerDecisions {
+		if pd.Player.Id == player.Id {
+			continue
+		}
+		if clientId, exists := s.playerClient.get(pd.Player.Id); exists {
+			s.queueServerUpdatesAndSignal(ctx, clientId, updates...)
+			s.queueServerUpdatesAndSignal(ctx, clientId, s.initialServerUpdates(ctx, clientId)...)
+		}
+	}
+}
+
+// forfeitActiveGame awards an unfinished game to the opponent. A series the
+// game belongs to ends with it.
+func (s *Server) forfeitActiveGame(ctx context.Context, player *models.Player) {
+	gameId, exists := s.playerGame.get(player.Id)
+	if !exists {
+		return
+	}
+	s.playerGame.delete(player.Id)
+
+	game, exists := s.games.get(gameId)
+	if !exists || game.Ended() {
+		return
+	}
+
+	other := game.MoverX
+	if other.Id == player.Id {
+		other = game.MoverO
+	}
+
+	game.Result = models.GameResult_WIN_BY_FORFEIT
+	game.Winner = other
+
+	if series, exists := s.getGameSeries(game); exists {
+		s.series.delete(series.Id)
+	}
+
+	otherClientId, otherOnline := s.playerClient.get(other.Id)
+	if otherOnline {
+		s.queueServerUpdatesAndSignal(ctx, otherClientId,
+			s.createWinnerUpdate(true, Technicality_BY_FORFEIT),
+			s.createPlayerClientUpdate("Your opponent has left the game"),
+		)
+	}
+
+	if s.completeManagedGame(ctx, game) {
+		return
+	}
+
+	s.playerGame.delete(other.Id)
+	s.refreshPresence(ctx, other.Id)
+
+	if otherOnline {
+		s.queueServerUpdatesAndSignal(ctx, otherClientId, s.initialServerUpdates(ctx, otherClientId)...)
+	}
+}
+
+// withdrawFromTournaments removes the player from tournaments that are still
+// taking registrations and stops them spectating any. Matches in tournaments
+// already in progress are forfeited when they come up.
+func (s *Server) withdrawFromTournaments(ctx context.Context, player *models.Player) {
+	s.tournamentsMu.Lock()
+	defer s.tournamentsMu.Unlock()
+
+	changed := []*models.Tournament{}
+	s.tournaments.forEach(func(key string, tournament *models.Tournament) bool {
+		delete(tournament.Spectators, player.Id)
+		if _, exists := tournament.GetEntrant(player.Id); exists && tournament.Status == models.TournamentStatus_REGISTRATION {
+			tournament.Entrants = slices.DeleteFunc(tournament.Entrants, func(entrant *models.TournamentEntrant) bool {
+				return entrant.Player.Id == player.Id
+			})
+			changed = append(changed, tournament)
+		}
+		return true
+	})
+
+	for _, tournament := range changed {
+		s.queueTournamentBracketUpdate(ctx, tournament)
+	}
+}
+
+func (s *Server) withdrawPlayerInvitations(ctx context.Context, player *models.Player) {
+	challenges := []*models.Challenge{}
+	s.challenges.forEach(func(key string, challenge *models.Challenge) bool {
+		if challenge.Challenger.Id == player.Id || challenge.Challengee.Id == player.Id {
+			challenges = append(challenges, challenge)
+		}
+		return true
+	})
+	for _, challenge := range challenges {
+		s.closeChallenge(ctx, challenge, models.InvitationStatus_WITHDRAWN)
+	}
+
+	invitations := []*models.LobbyInvitation{}
+	s.lobbyInvitations.forEach(func(key string, invitation *models.LobbyInvitation) bool {
+		if invitation.Status == models.InvitationStatus_PENDING && (invitation.Inviter.Id == player.Id || invitation.Invitee.Id == player.Id) {
+			invitations = append(invitations, invitation)
+		}
+		return true
+	})
+	s.lobbyInvitationsMu.Lock()
+	defer s.lobbyInvitationsMu.Unlock()
+	for _, invitation := range invitations {
+		if !s.closeLobbyInvitation(invitation, models.InvitationStatus_WITHDRAWN) {
+			continue
+		}
+
+		update := s.createLobbyInvitationResponseUpdate(invitation)
+		for _, other := range []*models.Player{invitation.Inviter, invitation.Invitee} {
+			if other.Id == player.Id {
+				continue
+			}
+			if clientId, exists := s.playerClient.get(other.Id); exists {
+				s.queueServerUpdatesAndSignal(ctx, clientId, update)
+			}
+		}
+	}
+}
+
+// removePlayerRelations drops the player from the friend lists, friend
+// requests and block lists of every other player.
+func (s *Server) removePlayerRelations(ctx context.Context, player *models.Player) {
+	others := []*models.Player{}
+	s.players.forEach(func(key string, other *models.Player) bool {
+		if other.Id == player.Id {
+			return true
+		}
+		if other.Friends[player.Id] || other.IncomingFriendRequests[player.Id] || other.OutgoingFriendRequests[player.Id] || other.Blocked[player.Id] {
+			others = append(others, other)
+		}
+		return true
+	})
+
+	for _, other := range others {
+		other.RemoveFriend(player.Id)
+		other.Unblock(player.Id)
+		if clientId, exists := s.playerClient.get(other.Id); exists {
+			s.queueServerUpdatesAndSignal(ctx, clientId,
+				s.createFriendListUpdate(other),
+				s.createBlockListUpdate(other),
+			)
+		}
+	}
+}
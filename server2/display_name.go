@@ -0,0 +1,246 @@
+package server2
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	minDisplayNameLength = 3
+	maxDisplayNameLength = 24
+
+	generatedDisplayNameAttempts = 5
+)
+
+var reservedDisplayNames = []string{
+	"admin",
+	"administrator",
+	"moderator",
+	"mod",
+	"system",
+	"server",
+	"support",
+	"staff",
+	"official",
+	"tctxto",
+	"deletedplayer",
+}
+
+// confusables maps characters that are commonly used to imitate latin letters
+// onto the letters they imitate. Ambiguous characters imitate more than one;
+// the first is the one used in skeletons. It is intentionally small and only
+// covers the lookalikes that survive NFKC normalization. Keys are matched
+// before lower casing, so 'I' can stand for 'l' as well as 'i'.
+var confusables = map[rune][]rune{
+	'0': {'o'}, '1': {'l', 'i'}, '3': {'e'}, '4': {'a'}, '5': {'s'}, '7': {'t'}, '8': {'b'},
+	'|': {'l', 'i'}, '$': {'s'}, '@': {'a'}, '!': {'i', 'l'},
+	'I': {'i', 'l'}, 'l': {'l', 'i'},
+	'а': {'a'}, 'в': {'b'}, 'е': {'e'}, 'ё': {'e'}, 'к': {'k'}, 'м': {'m'}, 'н': {'h'},
+	'о': {'o'}, 'р': {'p'}, 'с': {'c'}, 'т': {'t'}, 'у': {'y'}, 'х': {'x'}, 'і': {'i', 'l'},
+	'ј': {'j'}, 'ѕ': {'s'}, 'ԁ': {'d'}, 'ԛ': {'q'}, 'ԝ': {'w'},
+	'α': {'a'}, 'β': {'b'}, 'ε': {'e'}, 'η': {'n'}, 'ι': {'i', 'l'}, 'κ': {'k'}, 'ν': {'v'},
+	'ο': {'o'}, 'ρ': {'p'}, 'τ': {'t'}, 'υ': {'u'}, 'χ': {'x'},
+}
+
+// normalizeDisplayName applies NFKC normalization, trims the name and
+// collapses runs of whitespace into a single space.
+func normalizeDisplayName(displayName string) string {
+	return strings.Join(strings.Fields(norm.NFKC.String(displayName)), " ")
+}
+
+// displayNameSkeleton reduces a normalized display name to the form used to
+// compare names with each other: lower case, confusables folded onto latin
+// letters and everything but letters and digits removed.
+func displayNameSkeleton(displayName string) string {
+	var b strings.Builder
+	for _, readings := range displayNameReadings(displayName) {
+		b.WriteRune(readings[0])
+	}
+	return strings.ReplaceAll(b.String(), "rn", "m")
+}
+
+// displayNameReadings returns, for every letter or digit kept in the
+// skeleton, each latin letter it can be read as.
+func displayNameReadings(displayName string) [][]rune {
+	readings := [][]rune{}
+	for _, r := range displayName {
+		if c, exists := confusables[r]; exists {
+			readings = append(readings, c)
+			continue
+		}
+		r = unicode.ToLower(r)
+		if c, exists := confusables[r]; exists {
+			readings = append(readings, c)
+			continue
+		}
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			readings = append(readings, []rune{r})
+		}
+	}
+	return readings
+}
+
+// displayNameReadsAs reports whether some reading of the whole display name
+// spells word.
+func displayNameReadsAs(displayName, word string) bool {
+	return spells(displayNameReadings(displayName), []rune(word), true)
+}
+
+// displayNameContains reports whether some reading of part of the display
+// name spells word.
+func displayNameContains(displayName, word string) bool {
+	readings := displayNameReadings(displayName)
+	for i := range readings {
+		if spells(readings[i:], []rune(word), false) {
+			return true
+		}
+	}
+	return false
+}
+
+// spells reports whether the readings, from the start, spell word, and when
+// whole is set, nothing more. "rn" also reads as "m".
+func spells(readings [][]rune, word []rune, whole bool) bool {
+	if len(word) == 0 {
+		return !whole || len(readings) == 0
+	}
+	if len(readings) == 0 {
+		return false
+	}
+	if slices.Contains(readings[0], word[0]) && spells(readings[1:], word[1:], whole) {
+		return true
+	}
+	return word[0] == 'm' && len(readings) > 1 &&
+		slices.Contains(readings[0], 'r') && slices.Contains(readings[1], 'n') &&
+		spells(readings[2:], word[1:], whole)
+}
+
+// validateDisplayName runs a requested display name through the validation
+// pipeline and returns the normalized name that should be stored.
+func (s *Server) validateDisplayName(displayName string) (string, *Outcome) {
+	if !utf8.ValidString(displayName) {
+		return "", &Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.InvalidArgument),
+			ErrorMessage: "display name is not valid text",
+		}
+	}
+
+	displayName = normalizeDisplayName(displayName)
+
+	length := utf8.RuneCountInString(displayName)
+	if length < minDisplayNameLength || length > maxDisplayNameLength {
+		return "", &Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.InvalidArgument),
+			ErrorMessage: fmt.Sprintf("display name must be between %d and %d characters", minDisplayNameLength, maxDisplayNameLength),
+		}
+	}
+
+	for _, r := range displayName {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsMark(r) && !strings.ContainsRune(" _-.", r) {
+			return "", &Outcome{
+				Ok:           false,
+				ErrorCode:    int32(codes.InvalidArgument),
+				ErrorMessage: "display name may only contain letters, digits, spaces, '_', '-' and '.'",
+			}
+		}
+	}
+
+	if mixesScripts(displayName) {
+		return "", &Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.InvalidArgument),
+			ErrorMessage: "display name mixes lookalike alphabets",
+		}
+	}
+
+	for _, reserved := range reservedDisplayNames {
+		if displayNameReadsAs(displayName, reserved) {
+			return "", &Outcome{
+				Ok:           false,
+				ErrorCode:    int32(codes.InvalidArgument),
+				ErrorMessage: "display name is reserved",
+			}
+		}
+	}
+
+	for _, denied := range s.displayNameDenyList {
+		if displayNameContains(displayName, displayNameSkeleton(denied)) {
+			return "", &Outcome{
+				Ok:           false,
+				ErrorCode:    int32(codes.InvalidArgument),
+				ErrorMessage: "display name is not allowed",
+			}
+		}
+	}
+
+	return displayName, &Outcome{Ok: true}
+}
+
+// mixesScripts reports whether the name combines latin, cyrillic or greek
+// letters, which is how most homoglyph impersonation is done.
+func mixesScripts(displayName string) bool {
+	scripts := map[*unicode.RangeTable]bool{}
+	for _, r := range displayName {
+		for _, script := range []*unicode.RangeTable{unicode.Latin, unicode.Cyrillic, unicode.Greek} {
+			if unicode.Is(script, r) {
+				scripts[script] = true
+			}
+		}
+	}
+	return len(scripts) > 1
+}
+
+// claimDisplayName records the player as the owner of the display name when
+// unique display names are enforced. It reports false if another player
+// already owns a name that looks the same. The caller must hold accountsMu.
+func (s *Server) claimDisplayName(playerId, displayName string) bool {
+	if !s.uniqueDisplayNames {
+		return true
+	}
+
+	skeleton := displayNameSkeleton(displayName)
+	if ownerId, exists := s.displayNameOwners.get(skeleton); exists && ownerId != playerId {
+		return false
+	}
+
+	s.displayNameOwners.set(skeleton, playerId)
+	return true
+}
+
+// claimGeneratedDisplayName claims a random display name made of prefix and n
+// random characters for the player, drawing again while the name is taken.
+// The caller must hold accountsMu.
+func (s *Server) claimGeneratedDisplayName(playerId, prefix string, n int) (string, *Outcome) {
+	for attempt := 0; attempt < generatedDisplayNameAttempts; attempt++ {
+		displayName := prefix + s.generateRandomString(n)
+		if s.claimDisplayName(playerId, displayName) {
+			return displayName, &Outcome{Ok: true}
+		}
+	}
+	return "", &Outcome{
+		Ok:           false,
+		ErrorCode:    int32(codes.Internal),
+		ErrorMessage: "unable to generate a display name",
+	}
+}
+
+// releaseDisplayName frees a display name claimed by the player. The caller
+// must hold accountsMu.
+func (s *Server) releaseDisplayName(playerId, displayName string) {
+	if !s.uniqueDisplayNames {
+		return
+	}
+
+	skeleton := displayNameSkeleton(displayName)
+	if ownerId, exists := s.displayNameOwners.get(skeleton); exists && ownerId == playerId {
+		s.displayNameOwners.delete(skeleton)
+	}
+}
@@ -0,0 +1,72 @@
+package server2
+
+import (
+	"fmt"
+	"testing"
+	"txtcto/models"
+)
+
+func TestValidateDisplayNameRefusesReservedLookalikes(t *testing.T) {
+	s := NewServer(map[string]*models.Consumer{}, WithJanitorInterval(0))
+
+	for _, displayName := range []string{
+		"admin",
+		"ADMIN",
+		"adm1n",
+		"admln",
+		"Adm|n",
+		"adm!n",
+		"m0derator",
+		"rnoderator",
+		"0fficiaI",
+		"offic1a1",
+		"$upp0rt",
+		"5t4ff",
+		"аdmin",
+	} {
+		if _, outcome := s.validateDisplayName(displayName); outcome.Ok {
+			t.Errorf("validateDisplayName(%q) was accepted, want it refused", displayName)
+		}
+	}
+}
+
+func TestValidateDisplayNameAcceptsOrdinaryNames(t *testing.T) {
+	s := NewServer(map[string]*models.Consumer{}, WithJanitorInterval(0))
+
+	for _, displayName := range []string{
+		"alice",
+		"admiral",
+		"Bob 42",
+		"mod_squad",
+		"lil.tic",
+	} {
+		if _, outcome := s.validateDisplayName(displayName); !outcome.Ok {
+			t.Errorf("validateDisplayName(%q) was refused: %s", displayName, outcome.ErrorMessage)
+		}
+	}
+}
+
+func TestValidateDisplayNameDenyListMatchesLookalikes(t *testing.T) {
+	s := NewServer(map[string]*models.Consumer{}, WithJanitorInterval(0), WithDisplayNameDenyList([]string{"villain"}))
+
+	for _, displayName := range []string{
+		"villain",
+		"the v1lla1n",
+		"VIlLAIN99",
+		"vi11ain",
+	} {
+		if _, outcome := s.validateDisplayName(displayName); outcome.Ok {
+			t.Errorf("validateDisplayName(%q) was accepted, want it refused", displayName)
+		}
+	}
+}
+
+func TestValidateDisplayNameLengthMessage(t *testing.T) {
+	s := NewServer(map[string]*models.Consumer{}, WithJanitorInterval(0))
+
+	_, outcome := s.validateDisplayName("ab")
+	want := fmt.Sprintf("display name must be between %d and %d characters", minDisplayNameLength, maxDisplayNameLength)
+	if outcome.Ok || outcome.ErrorMessage != want {
+		t.Errorf("validateDisplayName(%q) = %q, want %q", "ab", outcome.ErrorMessage, want)
+	}
+}
@@ -0,0 +1,27 @@
+package server2
+
+import "context"
+
+func (s *Server) getTournamentDetails(ctx context.Context, clientId string, in *GetTournamentRequest) error {
+	_, outcome := s.validatePlayer(ctx, clientId)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createGetTournamentReply(outcome))
+		return nil
+	}
+
+	s.tournamentsMu.Lock()
+	defer s.tournamentsMu.Unlock()
+
+	tournament, outcome := s.getTournament(in.TournamentId)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createGetTournamentReply(outcome))
+		return nil
+	}
+
+	s.queueServerUpdatesAndSignal(ctx, clientId,
+		s.createGetTournamentReply(&Outcome{Ok: true}),
+		s.createTournamentBracketUpdate(tournament),
+	)
+
+	return nil
+}
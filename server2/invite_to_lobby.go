@@ -0,0 +1,207 @@
+package server2
+
+import (
+	"context"
+	"time"
+	"txtcto/models"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+)
+
+const lobbyInvitationTTL = 5 * time.Minute
+
+func (s *Server) inviteToLobby(ctx context.Context, clientId string, in *InviteToLobbyRequest) error {
+	inviter, outcome := s.validatePlayer(ctx, clientId)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createInviteToLobbyReply(outcome))
+		return nil
+	}
+
+	lobbyId, exists := s.playerLobby.get(inviter.Id)
+	if !exists {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createInviteToLobbyReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.NotFound),
+			ErrorMessage: "player does not belong to any lobby",
+		}))
+		return nil
+	}
+
+	lobby, exists := s.lobbies.get(lobbyId)
+	if !exists {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createInviteToLobbyReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.NotFound),
+			ErrorMessage: "lobby does not exists",
+		}))
+		return nil
+	}
+
+	inviteeId, exists := s.playerNameId.get(in.PlayerName)
+	if !exists {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createInviteToLobbyReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.NotFound),
+			ErrorMessage: "player with name not found",
+		}))
+		return nil
+	}
+
+	invitee, exists := s.players.get(inviteeId)
+	if !exists {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createInviteToLobbyReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.NotFound),
+			ErrorMessage: "player not found",
+		}))
+		return nil
+	}
+
+	if invitee.Id == inviter.Id {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createInviteToLobbyReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.InvalidArgument),
+			ErrorMessage: "you can not invite yourself",
+		}))
+		return nil
+	}
+
+	if outcome := s.validateNotBlocked(inviter, invitee); !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createInviteToLobbyReply(outcome))
+		return nil
+	}
+
+	if _, exists := lobby.Players[invitee.Id]; exists {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createInviteToLobbyReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.AlreadyExists),
+			ErrorMessage: "player is already in your lobby",
+		}))
+		return nil
+	}
+
+	for _, invitation := range s.pendingLobbyInvitations(invitee.Id) {
+		if invitation.Lobby.Id == lobby.Id {
+			s.queueServerUpdatesAndSignal(ctx, clientId, s.createInviteToLobbyReply(&Outcome{
+				Ok:           false,
+				ErrorCode:    int32(codes.AlreadyExists),
+				ErrorMessage: "player has already been invited to your lobby",
+			}))
+			return nil
+		}
+	}
+
+	invitation := &models.LobbyInvitation{
+		Id:        uuid.New().String(),
+		Lobby:     lobby,
+		Inviter:   inviter,
+		Invitee:   invitee,
+		ExpiresAt: time.Now().Add(lobbyInvitationTTL),
+		Status:    models.InvitationStatus_PENDING,
+	}
+
+	s.lobbyInvitations.set(invitation.Id, invitation)
+	ids, _ := s.playerLobbyInvitations.get(invitee.Id)
+	s.playerLobbyInvitations.set(invitee.Id, append(ids, invitation.Id))
+
+	time.AfterFunc(lobbyInvitationTTL, func() {
+		s.expireLobbyInvitation(context.Background(), invitation.Id)
+	})
+
+	s.queueServerUpdatesAndSignal(ctx, clientId, s.createInviteToLobbyReply(&Outcome{Ok: true}))
+
+	if inviteeClientId, exists := s.playerClient.get(invitee.Id); exists {
+		s.queueServerUpdatesAndSignal(ctx, inviteeClientId, s.createLobbyInvitationUpdate(invitation))
+	}
+
+	return nil
+}
+
+func (s *Server) pendingLobbyInvitations(playerId string) []*models.LobbyInvitation {
+	ids, exists := s.playerLobbyInvitations.get(playerId)
+	if !exists {
+		return nil
+	}
+
+	now := time.Now()
+	invitations := []*models.LobbyInvitation{}
+	for _, id := range ids {
+		invitation, exists := s.lobbyInvitations.get(id)
+		if !exists || invitation.Status != models.InvitationStatus_PENDING || invitation.Expired(now) {
+			continue
+		}
+		invitations = append(invitations, invitation)
+	}
+	return invitations
+}
+
+func (s *Server) removeLobbyInvitation(invitation *models.LobbyInvitation) {
+	s.lobbyInvitations.delete(invitation.Id)
+
+	ids, exists := s.playerLobbyInvitations.get(invitation.Invitee.Id)
+	if !exists {
+		return
+	}
+
+	remaining := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id != invitation.Id {
+			remaining = append(remaining, id)
+		}
+	}
+
+	if len(remaining) == 0 {
+		s.playerLobbyInvitations.delete(invitation.Invitee.Id)
+	} else {
+		s.playerLobbyInvitations.set(invitation.Invitee.Id, remaining)
+	}
+}
+
+// closeLobbyInvitation settles a pending invitation with the given status and
+// reports whether it did. The caller holds lobbyInvitationsMu, so only one of
+// an expiry, a withdrawal and a response can settle it.
+func (s *Server) closeLobbyInvitation(invitation *models.LobbyInvitation, status models.InvitationStatus) bool {
+	if _, exists := s.lobbyInvitations.get(invitation.Id); !exists || invitation.Status != models.InvitationStatus_PENDING {
+		return false
+	}
+
+	invitation.Status = status
+	s.removeLobbyInvitation(invitation)
+	return true
+}
+
+func (s *Server) expireLobbyInvitation(ctx context.Context, invitationId string) {
+	s.lobbyInvitationsMu.Lock()
+	defer s.lobbyInvitationsMu.Unlock()
+
+	invitation, exists := s.lobbyInvitations.get(invitationId)
+	if !exists || !s.closeLobbyInvitation(invitation, models.InvitationStatus_EXPIRED) {
+		return
+	}
+
+	s.queueLobbyInvitationResponseUpdate(ctx, invitation)
+}
+
+// queueLobbyInvitationResponseUpdate tells the inviter and the invitee how an
+// invitation was settled.
+func (s *Server) queueLobbyInvitationResponseUpdate(ctx context.Context, invitation *models.LobbyInvitation) {
+	update := s.createLobbyInvitationResponseUpdate(invitation)
+
+	if inviterClientId, exists := s.playerClient.get(invitation.Inviter.Id); exists {
+		s.queueServerUpdatesAndSignal(ctx, inviterClientId, update)
+	}
+
+	if inviteeClientId, exists := s.playerClient.get(invitation.Invitee.Id); exists {
+		s.queueServerUpdatesAndSignal(ctx, inviteeClientId, update)
+	}
+}
+
+func (s *Server) getLobbyInvitationInitialUpdates(playerId string) []*ServerUpdate {
+	invitations := s.pendingLobbyInvitations(playerId)
+	updates := make([]*ServerUpdate, 0, len(invitations))
+	for _, invitation := range invitations {
+		updates = append(updates, s.createLobbyInvitationUpdate(invitation))
+	}
+	return updates
+}
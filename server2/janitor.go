@@ -0,0 +1,348 @@
+package server2
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+	"time"
+	"txtcto/models"
+)
+
+const (
+	// DefaultJanitorInterval and DefaultIdleClientTTL are used when
+	// WithJanitorInterval and WithIdleClientTTL are not given.
+	DefaultJanitorInterval = time.Minute
+	DefaultIdleClientTTL   = 10 * time.Minute
+	signInAttemptsTTL      = signInFailureWindow + signInMaxBackoff
+	// endedTournamentTTL is how long the final bracket of a tournament stays
+	// available after it finished or was cancelled.
+	endedTournamentTTL = time.Hour
+)
+
+// janitorStats counts what the janitor has reclaimed since the server started.
+type janitorStats struct {
+	sweeps         atomic.Int64
+	clients        atomic.Int64
+	games          atomic.Int64
+	lobbies        atomic.Int64
+	rematches      atomic.Int64
+	series         atomic.Int64
+	tournaments    atomic.Int64
+	invitations    atomic.Int64
+	presence       atomic.Int64
+	tokenBuckets   atomic.Int64
+	signInAttempts atomic.Int64
+	announcements  atomic.Int64
+}
+
+func (s *Server) touchClient(clientId string) {
+	s.clientLastSeen.set(clientId, time.Now())
+}
+
+// runJanitor sweeps on every tick of the janitor interval. It never returns,
+// so it is meant to be run on its own goroutine.
+func (s *Server) runJanitor(ctx context.Context) {
+	ticker := time.NewTicker(s.janitorInterval)
+	defer ticker.Stop()
+
+	for now := range ticker.C {
+		s.sweep(ctx, now)
+	}
+}
+
+// sweep evicts idle clients and any state nothing refers to any more. Entries
+// are collected first and deleted afterwards because forEach holds the read
+// lock of the map it walks.
+func (s *Server) sweep(ctx context.Context, now time.Time) {
+	clients := s.sweepIdleClients(ctx, now)
+	rematches := s.sweepRematches()
+	games := s.sweepGames()
+	series := s.sweepSeries()
+	lobbies := s.sweepLobbies()
+	tournaments := s.sweepTournaments(now)
+	invitations := s.sweepInvitations(ctx, now)
+	presence := s.sweepPresence()
+	tokenBuckets := s.sweepTokenBuckets(now)
+	signInAttempts := s.sweepSignInAttempts(now)
+	announcements := s.sweepAnnouncements(now)
+
+	s.janitorStats.sweeps.Add(1)
+	s.janitorStats.clients.Add(int64(clients))
+	s.janitorStats.rematches.Add(int64(rematches))
+	s.janitorStats.games.Add(int64(games))
+	s.janitorStats.series.Add(int64(series))
+	s.janitorStats.lobbies.Add(int64(lobbies))
+	s.janitorStats.tournaments.Add(int64(tournaments))
+	s.janitorStats.invitations.Add(int64(invitations))
+	s.janitorStats.presence.Add(int64(presence))
+	s.janitorStats.tokenBuckets.Add(int64(tokenBuckets))
+	s.janitorStats.signInAttempts.Add(int64(signInAttempts))
+	s.janitorStats.announcements.Add(int64(announcements))
+
+	if clients+rematches+games+series+lobbies+tournaments+invitations+presence+tokenBuckets+signInAttempts+announcements > 0 {
+		s.logger.Info("janitor: evicted",
+			slog.Int("clients", clients),
+			slog.Int("rematches", rematches),
+			slog.Int("games", games),
+			slog.Int("series", series),
+			slog.Int("lobbies", lobbies),
+			slog.Int("tournaments", tournaments),
+			slog.Int("invitations", invitations),
+			slog.Int("presence", presence),
+			slog.Int("token_buckets", tokenBuckets),
+			slog.Int("sign_in_attempts", signInAttempts),
+			slog.Int("announcements", announcements),
+		)
+	}
+}
+
+// sweepIdleClients evicts clients that have not been seen for longer than the
+// idle client TTL. Subscribed clients are seen on every ping, so a client that
+// still has a stream but is not seen any more never disconnected cleanly; its
+// stream is told to end. A player whose only client is evicted stays signed
+// up but goes offline.
+func (s *Server) sweepIdleClients(ctx context.Context, now time.Time) int {
+	idle := []string{}
+	s.clients.forEach(func(clientId string, client *models.Client) bool {
+		ttl := s.idleClientTTL
+		if _, connected := s.clientSignal.get(clientId); connected {
+			ttl = max(ttl, 2*s.pingInterval)
+		}
+		if lastSeen, exists := s.clientLastSeen.get(clientId); exists && now.Sub(lastSeen) < ttl {
+			return true
+		}
+		idle = append(idle, clientId)
+		return true
+	})
+
+	s.clientSignal.forEach(func(clientId string, signal chan struct{}) bool {
+		if _, exists := s.clients.get(clientId); !exists {
+			idle = append(idle, clientId)
+		}
+		return true
+	})
+
+	for _, clientId := range idle {
+		s.disconnectClient(clientId)
+		s.clientSignal.delete(clientId)
+		s.clients.delete(clientId)
+		s.clientLastSeen.delete(clientId)
+		s.clientServerUpdates.delete(clientId)
+		s.clientLastIndexServerUpdate.delete(clientId)
+		s.clientDisconnect.delete(clientId)
+		s.clientConsumer.delete(clientId)
+		s.clientBuckets.delete(clientId)
+		s.clientSignInAttempts.delete(clientId)
+
+		if playerId, exists := s.clientPlayer.get(clientId); exists {
+			s.clientPlayer.delete(clientId)
+			if playerClientId, exists := s.playerClient.get(playerId); exists && playerClientId == clientId {
+				s.playerClient.delete(playerId)
+				s.refreshPresence(ctx, playerId)
+			}
+		}
+	}
+
+	return len(idle)
+}
+
+// sweepRematches evicts rematches that neither player is waiting on.
+func (s *Server) sweepRematches() int {
+	referenced := map[string]bool{}
+	s.playerRematch.forEach(func(playerId string, rematchId string) bool {
+		referenced[rematchId] = true
+		return true
+	})
+
+	stale := []string{}
+	s.rematches.forEach(func(rematchId string, rematch *models.Rematch) bool {
+		if !referenced[rematchId] {
+			stale = append(stale, rematchId)
+		}
+		return true
+	})
+
+	for _, rematchId := range stale {
+		s.rematches.delete(rematchId)
+	}
+
+	return len(stale)
+}
+
+// sweepGames evicts games that no player is in and no rematch refers to.
+// Games are registered for their players before they are stored, so a game
+// that is being set up is never mistaken for an orphan. Between the games of
+// a series the players are in no game for a moment, so games of a series that
+// is still going on are kept.
+func (s *Server) sweepGames() int {
+	referenced := map[string]bool{}
+	s.playerGame.forEach(func(playerId string, gameId string) bool {
+		referenced[gameId] = true
+		return true
+	})
+	s.rematches.forEach(func(rematchId string, rematch *models.Rematch) bool {
+		referenced[rematch.GameId] = true
+		return true
+	})
+
+	orphaned := []string{}
+	s.games.forEach(func(gameId string, game *models.Game) bool {
+		if referenced[gameId] {
+			return true
+		}
+		if series, exists := s.getGameSeries(game); exists && !series.Decided() {
+			return true
+		}
+		orphaned = append(orphaned, gameId)
+		return true
+	})
+
+	for _, gameId := range orphaned {
+		s.games.delete(gameId)
+	}
+
+	return len(orphaned)
+}
+
+// sweepSeries evicts decided series that no stored game belongs to any more.
+// A series that is not decided yet is about to start its next game.
+func (s *Server) sweepSeries() int {
+	referenced := map[string]bool{}
+	s.games.forEach(func(gameId string, game *models.Game) bool {
+		if game.SeriesId != "" {
+			referenced[game.SeriesId] = true
+		}
+		return true
+	})
+
+	orphaned := []string{}
+	s.series.forEach(func(seriesId string, series *models.Series) bool {
+		if !referenced[seriesId] && series.Decided() {
+			orphaned = append(orphaned, seriesId)
+		}
+		return true
+	})
+
+	for _, seriesId := range orphaned {
+		s.series.delete(seriesId)
+	}
+
+	return len(orphaned)
+}
+
+// sweepLobbies evicts lobbies that everyone has left.
+func (s *Server) sweepLobbies() int {
+	empty := []string{}
+	s.lobbies.forEach(func(lobbyId string, lobby *models.Lobby) bool {
+		if len(lobby.Players) == 0 {
+			empty = append(empty, lobbyId)
+		}
+		return true
+	})
+
+	for _, lobbyId := range empty {
+		s.lobbies.delete(lobbyId)
+	}
+
+	return len(empty)
+}
+
+// sweepTournaments evicts tournaments that ended longer ago than the ended
+// tournament TTL.
+func (s *Server) sweepTournaments(now time.Time) int {
+	s.tournamentsMu.Lock()
+	defer s.tournamentsMu.Unlock()
+
+	ended := []string{}
+	s.tournaments.forEach(func(tournamentId string, tournament *models.Tournament) bool {
+		if !tournament.EndedAt.IsZero() && now.Sub(tournament.EndedAt) > endedTournamentTTL {
+			ended = append(ended, tournamentId)
+		}
+		return true
+	})
+
+	for _, tournamentId := range ended {
+		s.tournaments.delete(tournamentId)
+	}
+
+	return len(ended)
+}
+
+// sweepInvitations expires challenges and lobby invitations whose timer has
+// not done so, and drops any that were closed but left behind.
+func (s *Server) sweepInvitations(ctx context.Context, now time.Time) int {
+	challenges := []*models.Challenge{}
+	s.challenges.forEach(func(challengeId string, challenge *models.Challenge) bool {
+		if challenge.Status != models.InvitationStatus_PENDING || challenge.Expired(now) {
+			challenges = append(challenges, challenge)
+		}
+		return true
+	})
+
+	invitations := []*models.LobbyInvitation{}
+	s.lobbyInvitations.forEach(func(invitationId string, invitation *models.LobbyInvitation) bool {
+		if invitation.Status != models.InvitationStatus_PENDING || invitation.Expired(now) {
+			invitations = append(invitations, invitation)
+		}
+		return true
+	})
+
+	for _, challenge := range challenges {
+		if !s.closeChallenge(ctx, challenge, models.InvitationStatus_EXPIRED) {
+			s.challenges.delete(challenge.Id)
+		}
+	}
+
+	for _, invitation := range invitations {
+		if invitation.Status == models.InvitationStatus_PENDING {
+			s.expireLobbyInvitation(ctx, invitation.Id)
+		} else {
+			s.removeLobbyInvitation(invitation)
+		}
+	}
+
+	return len(challenges) + len(invitations)
+}
+
+// sweepPresence forgets the last pushed presence of players who are offline
+// or gone. Offline is what friends assume without one, so nothing is pushed
+// twice.
+func (s *Server) sweepPresence() int {
+	stale := []string{}
+	s.playerPresence.forEach(func(playerId string, presence Presence) bool {
+		if _, exists := s.players.get(playerId); !exists || presence == Presence_OFFLINE {
+			stale = append(stale, playerId)
+		}
+		return true
+	})
+
+	for _, playerId := range stale {
+		s.playerPresence.delete(playerId)
+	}
+
+	return len(stale)
+}
+
+// sweepSignInAttempts forgets failed sign ins that can no longer lead to a
+// lockout and lockouts that have run out.
+func (s *Server) sweepSignInAttempts(now time.Time) int {
+	s.signInMu.Lock()
+	defer s.signInMu.Unlock()
+
+	evicted := 0
+	for _, attemptsByKey := range []*safeMap[string, *models.SignInAttempts]{s.nameSignInAttempts, s.clientSignInAttempts, s.sourceSignInAttempts} {
+		stale := []string{}
+		attemptsByKey.forEach(func(key string, attempts *models.SignInAttempts) bool {
+			if now.Sub(attempts.LastFailureAt) > signInAttemptsTTL && !now.Before(attempts.LockedUntil) {
+				stale = append(stale, key)
+			}
+			return true
+		})
+		for _, key := range stale {
+			attemptsByKey.delete(key)
+		}
+		evicted += len(stale)
+	}
+
+	return evicted
+}
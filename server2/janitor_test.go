@@ -0,0 +1,167 @@
+package server2
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+	"txtcto/models"
+)
+
+const janitorTestConsumer = "consumer"
+
+func newJanitorTestServer() *Server {
+	return NewServer(
+		map[string]*models.Consumer{janitorTestConsumer: {PublicKey: janitorTestConsumer}},
+		WithJanitorInterval(0),
+		WithIdleClientTTL(time.Minute),
+	)
+}
+
+// connectJanitorTestClient sets a client up the way Subscribe does.
+func connectJanitorTestClient(s *Server, clientId string) {
+	s.clients.set(clientId, &models.Client{Id: clientId})
+	s.clientServerUpdates.set(clientId, []queuedUpdate{})
+	s.clientSignal.set(clientId, make(chan struct{}, 1))
+	s.clientDisconnect.set(clientId, make(chan struct{}, 1))
+	s.clientConsumer.set(clientId, janitorTestConsumer)
+	s.touchClient(clientId)
+}
+
+func signUpJanitorTestPlayer(t *testing.T, s *Server, clientId string) *models.Player {
+	t.Helper()
+
+	connectJanitorTestClient(s, clientId)
+	s.signUp(context.Background(), clientId, &SignUpRequest{Name: "name-" + clientId, Pass: "pass-" + clientId})
+
+	playerId, exists := s.clientPlayer.get(clientId)
+	if !exists {
+		t.Fatalf("client %s did not sign up", clientId)
+	}
+	player, _ := s.players.get(playerId)
+	return player
+}
+
+func countEntries[K comparable, V any](m *safeMap[K, V]) int {
+	count := 0
+	m.forEach(func(key K, value V) bool {
+		count++
+		return true
+	})
+	return count
+}
+
+func mustGet(t *testing.T, m *safeMap[string, string], key string) string {
+	t.Helper()
+
+	value, exists := m.get(key)
+	if !exists {
+		t.Fatalf("no entry for %s", key)
+	}
+	return value
+}
+
+func TestSweepEvictsChurnedState(t *testing.T) {
+	s := newJanitorTestServer()
+	ctx := context.Background()
+
+	const pairs = 25
+	for i := 0; i < pairs; i++ {
+		x := signUpJanitorTestPlayer(t, s, fmt.Sprintf("x%d", i))
+		o := signUpJanitorTestPlayer(t, s, fmt.Sprintf("o%d", i))
+
+		if allowed := s.allowClientUpdate(fmt.Sprintf("x%d", i), &ClientUpdate{}); !allowed.Ok {
+			t.Fatalf("client update refused: %s", allowed.ErrorMessage)
+		}
+
+		game, outcome := s.setupGame(ctx, x, x, o)
+		if !outcome.Ok {
+			t.Fatalf("setupGame: %s", outcome.ErrorMessage)
+		}
+		game.Result = models.GameResult_DRAW
+		s.playerGame.delete(x.Id)
+		s.playerGame.delete(o.Id)
+	}
+
+	for i := 0; i < pairs; i++ {
+		s.cleanupClientResources(ctx, fmt.Sprintf("x%d", i))
+		s.cleanupClientResources(ctx, fmt.Sprintf("o%d", i))
+	}
+
+	// A client whose stream went away without cleaning up after itself.
+	connectJanitorTestClient(s, "stale")
+
+	tournament := &models.Tournament{Id: "ended", Status: models.TournamentStatus_FINISHED, EndedAt: time.Now()}
+	s.tournaments.set(tournament.Id, tournament)
+
+	challenger, _ := s.players.get(mustGet(t, s.clientPlayer, "x0"))
+	challengee, _ := s.players.get(mustGet(t, s.clientPlayer, "o0"))
+	challenge := &models.Challenge{Id: "expired", Challenger: challenger, Challengee: challengee, ExpiresAt: time.Now(), Status: models.InvitationStatus_PENDING}
+	s.challenges.set(challenge.Id, challenge)
+
+	s.sweep(ctx, time.Now().Add(2*endedTournamentTTL))
+
+	for name, size := range map[string]int{
+		"clients":             countEntries(s.clients),
+		"clientSignal":        countEntries(s.clientSignal),
+		"clientServerUpdates": countEntries(s.clientServerUpdates),
+		"clientPlayer":        countEntries(s.clientPlayer),
+		"clientConsumer":      countEntries(s.clientConsumer),
+		"playerClient":        countEntries(s.playerClient),
+		"playerPresence":      countEntries(s.playerPresence),
+		"clientBuckets":       countEntries(s.clientBuckets),
+		"consumerBuckets":     countEntries(s.consumerBuckets),
+		"games":               countEntries(s.games),
+		"tournaments":         countEntries(s.tournaments),
+		"challenges":          countEntries(s.challenges),
+	} {
+		if size != 0 {
+			t.Errorf("%s has %d entries after the sweep, want 0", name, size)
+		}
+	}
+
+	if players := countEntries(s.players); players != 2*pairs {
+		t.Errorf("players has %d entries after the sweep, want %d", players, 2*pairs)
+	}
+}
+
+func TestSweepKeepsSeriesBetweenGames(t *testing.T) {
+	s := newJanitorTestServer()
+	ctx := context.Background()
+
+	x := signUpJanitorTestPlayer(t, s, "x")
+	o := signUpJanitorTestPlayer(t, s, "o")
+
+	game, outcome := s.setupGame(ctx, x, x, o)
+	if !outcome.Ok {
+		t.Fatalf("setupGame: %s", outcome.ErrorMessage)
+	}
+	series := s.setupSeries(game, 3)
+	game.Result = models.GameResult_WIN
+	game.Winner = x
+	series.Wins[0]++
+
+	// recordSeriesResult takes the players out of the game before the next
+	// one is set up.
+	s.playerGame.delete(x.Id)
+	s.playerGame.delete(o.Id)
+
+	s.sweep(ctx, time.Now())
+
+	if _, exists := s.games.get(game.Id); !exists {
+		t.Error("the last game of a series that is going on was evicted")
+	}
+	if _, exists := s.series.get(series.Id); !exists {
+		t.Error("a series that is going on was evicted")
+	}
+
+	series.Wins[0]++
+	s.sweep(ctx, time.Now())
+
+	if size := countEntries(s.games); size != 0 {
+		t.Errorf("games has %d entries after the series was decided, want 0", size)
+	}
+	if size := countEntries(s.series); size != 0 {
+		t.Errorf("series has %d entries after the series was decided, want 0", size)
+	}
+}
@@ -1,54 +1,96 @@
 package server2
 
 import (
+	"context"
+	"txtcto/models"
+
 	"google.golang.org/grpc/codes"
 )
 
-func (s *Server) joinLobby(clientId string, in *JoinLobbyRequest) error {
-	player, outcome := s.validatePlayer(clientId)
+func (s *Server) joinLobby(ctx context.Context, clientId string, in *JoinLobbyRequest) error {
+	player, outcome := s.validatePlayer(ctx, clientId)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createJoinLobbyReply(outcome))
+		return nil
+	}
+
+	lobby, outcome := s.addPlayerToLobby(ctx, player, in.LobbyId)
 	if !outcome.Ok {
-		s.queueServerUpdatesAndSignal(clientId, s.createJoinLobbyReply(outcome))
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createJoinLobbyReply(outcome))
 		return nil
 	}
 
+	s.queueServerUpdatesAndSignal(ctx, clientId, s.createJoinLobbyReply(&Outcome{Ok: true}))
+	s.queueServerUpdatesAndSignal(ctx, clientId, s.getLobbyEntryUpdates(player, lobby)...)
+
+	return nil
+}
+
+// addPlayerToLobby puts the player in the lobby and tells the other members.
+// The player is sent nothing, so callers can reply before sending
+// getLobbyEntryUpdates.
+func (s *Server) addPlayerToLobby(ctx context.Context, player *models.Player, lobbyId string) (*models.Lobby, *Outcome) {
 	if _, exists := s.playerLobby.get(player.Id); exists {
-		s.queueServerUpdatesAndSignal(clientId, s.createJoinLobbyReply(&Outcome{
+		return nil, &Outcome{
 			Ok:           false,
 			ErrorCode:    int32(codes.AlreadyExists),
 			ErrorMessage: "player has already in a lobby",
-		}))
+		}
 	}
 
-	lobby, exists := s.lobbies.get(in.LobbyId)
+	lobby, exists := s.lobbies.get(lobbyId)
 	if !exists {
-		s.queueServerUpdatesAndSignal(clientId, s.createJoinLobbyReply(&Outcome{
+		return nil, &Outcome{
 			Ok:           false,
 			ErrorCode:    int32(codes.NotFound),
 			ErrorMessage: "lobby does not exists",
-		}))
-		return nil
+		}
+	}
+
+	for _, member := range lobby.Players {
+		if member.Blocked[player.Id] {
+			return nil, &Outcome{
+				Ok:           false,
+				ErrorCode:    int32(codes.PermissionDenied),
+				ErrorMessage: "lobby is not available",
+			}
+		}
 	}
 
 	lobby.Players[player.Id] = player
+	lobby.SetReady(player.Id, false)
 
 	s.playerLobby.set(player.Id, lobby.Id)
+	s.refreshPresence(ctx, player.Id)
 
 	for _, member := range lobby.Players {
 		if member.Id == player.Id {
 			continue
 		}
 		if memberClientId, exists := s.playerClient.get(member.Id); exists {
-			s.queueServerUpdatesAndSignal(memberClientId,
+			s.queueServerUpdatesAndSignal(ctx, memberClientId,
 				s.createMyLobbyJoinerUpdate(player.Id, player.DisplayName),
 			)
 		}
 	}
 
-	s.queueServerUpdatesAndSignal(clientId,
-		s.createJoinLobbyReply(&Outcome{Ok: true}),
+	return lobby, &Outcome{Ok: true}
+}
+
+func (s *Server) getLobbyEntryUpdates(player *models.Player, lobby *models.Lobby) []*ServerUpdate {
+	updates := []*ServerUpdate{
 		s.createNavigationUpdate(NavigationPath_MY_LOBBY),
 		s.createMyLobbyDetails(lobby),
-	)
+		s.createLobbyReadyStateUpdate(lobby),
+		s.createPlayQueueUpdate(lobby),
+	}
 
-	return nil
+	for _, member := range lobby.Players {
+		if player.Blocked[member.Id] {
+			updates = append(updates, s.createPlayerClientUpdate("A player you have blocked is in this lobby"))
+			break
+		}
+	}
+
+	return updates
 }
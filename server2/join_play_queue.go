@@ -0,0 +1,52 @@
+package server2
+
+import (
+	"context"
+	"txtcto/models"
+
+	"google.golang.org/grpc/codes"
+)
+
+func (s *Server) joinPlayQueue(ctx context.Context, clientId string) error {
+	player, outcome := s.validatePlayer(ctx, clientId)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createJoinPlayQueueReply(outcome))
+		return nil
+	}
+
+	if outcome := s.checkMaintenance(); !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createJoinPlayQueueReply(outcome))
+		return nil
+	}
+
+	lobby, outcome := s.getPlayerLobby(player.Id)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createJoinPlayQueueReply(outcome))
+		return nil
+	}
+
+	if lobby.Mode != models.LobbyMode_WINNER_STAYS_ON {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createJoinPlayQueueReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.FailedPrecondition),
+			ErrorMessage: "lobby has no play queue",
+		}))
+		return nil
+	}
+
+	if !lobby.Enqueue(player.Id) {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createJoinPlayQueueReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.AlreadyExists),
+			ErrorMessage: "player is already in the play queue",
+		}))
+		return nil
+	}
+
+	s.queueServerUpdatesAndSignal(ctx, clientId, s.createJoinPlayQueueReply(&Outcome{Ok: true}))
+	s.queueServerUpdatesToLobby(ctx, lobby, s.createPlayQueueUpdate(lobby))
+
+	s.startNextQueuedGame(ctx, lobby)
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package server2
+
+import (
+	"context"
+	"txtcto/models"
+
+	"google.golang.org/grpc/codes"
+)
+
+func (s *Server) joinTournament(ctx context.Context, clientId string, in *JoinTournamentRequest) error {
+	player, outcome := s.validatePlayer(ctx, clientId)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createJoinTournamentReply(outcome))
+		return nil
+	}
+
+	if outcome := s.checkMaintenance(); !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createJoinTournamentReply(outcome))
+		return nil
+	}
+
+	s.tournamentsMu.Lock()
+	defer s.tournamentsMu.Unlock()
+
+	tournament, outcome := s.getTournament(in.TournamentId)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createJoinTournamentReply(outcome))
+		return nil
+	}
+
+	if tournament.Status != models.TournamentStatus_REGISTRATION {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createJoinTournamentReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.FailedPrecondition),
+			ErrorMessage: "tournament registration is closed",
+		}))
+		return nil
+	}
+
+	if _, exists := tournament.GetEntrant(player.Id); exists {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createJoinTournamentReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.AlreadyExists),
+			ErrorMessage: "player is already registered",
+		}))
+		return nil
+	}
+
+	tournament.Entrants = append(tournament.Entrants, &models.TournamentEntrant{Player: player})
+
+	s.queueServerUpdatesAndSignal(ctx, clientId, s.createJoinTournamentReply(&Outcome{Ok: true}))
+	s.queueTournamentBracketUpdate(ctx, tournament)
+
+	return nil
+}
@@ -1,17 +1,22 @@
 package server2
 
-import "google.golang.org/grpc/codes"
+import (
+	"context"
+	"txtcto/models"
 
-func (s *Server) leaveMyLobby(clientId string) error {
-	player, outcome := s.validatePlayer(clientId)
+	"google.golang.org/grpc/codes"
+)
+
+func (s *Server) leaveMyLobby(ctx context.Context, clientId string) error {
+	player, outcome := s.validatePlayer(ctx, clientId)
 	if !outcome.Ok {
-		s.queueServerUpdatesAndSignal(clientId, s.createLeaveMyLobbyReply(outcome))
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createLeaveMyLobbyReply(outcome))
 		return nil
 	}
 
 	lobbyId, exists := s.playerLobby.get(player.Id)
 	if !exists {
-		s.queueServerUpdatesAndSignal(clientId, s.createLeaveMyLobbyReply(&Outcome{
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createLeaveMyLobbyReply(&Outcome{
 			Ok:           false,
 			ErrorCode:    int32(codes.NotFound),
 			ErrorMessage: "player does not belong to any lobby",
@@ -20,7 +25,7 @@ func (s *Server) leaveMyLobby(clientId string) error {
 
 	lobby, exists := s.lobbies.get(lobbyId)
 	if !exists {
-		s.queueServerUpdatesAndSignal(clientId, s.createLeaveMyLobbyReply(&Outcome{
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createLeaveMyLobbyReply(&Outcome{
 			Ok:           false,
 			ErrorCode:    int32(codes.NotFound),
 			ErrorMessage: "lobby does not exists",
@@ -28,25 +33,49 @@ func (s *Server) leaveMyLobby(clientId string) error {
 		return nil
 	}
 
+	s.removePlayerFromLobby(ctx, player, lobby)
+
+	s.queueServerUpdatesAndSignal(ctx, clientId,
+		s.createLeaveMyLobbyReply(&Outcome{Ok: true}),
+		s.createNavigationUpdate(NavigationPath_HOME),
+	)
+
+	return nil
+}
+
+func (s *Server) removePlayerFromLobby(ctx context.Context, player *models.Player, lobby *models.Lobby) {
 	delete(lobby.Players, player.Id)
+	lobby.SetReady(player.Id, false)
+	lobby.Dequeue(player.Id)
+
+	hostChanged := false
+	if lobby.Creator.Id == player.Id {
+		for _, member := range lobby.Players {
+			lobby.Creator = member
+			hostChanged = true
+			break
+		}
+	}
 
 	s.playerLobby.delete(player.Id)
+	s.refreshPresence(ctx, player.Id)
 
 	for _, member := range lobby.Players {
 		if member.Id == player.Id {
 			continue
 		}
 		if memberClientId, exists := s.playerClient.get(member.Id); exists {
-			s.queueServerUpdatesAndSignal(memberClientId,
+			s.queueServerUpdatesAndSignal(ctx, memberClientId,
 				s.createMyLobbyLeaverUpdate(player.Id, player.Name),
 			)
 		}
 	}
 
-	s.queueServerUpdatesAndSignal(clientId,
-		s.createLeaveMyLobbyReply(&Outcome{Ok: true}),
-		s.createNavigationUpdate(NavigationPath_HOME),
+	if hostChanged {
+		s.queueServerUpdatesToLobby(ctx, lobby, s.createMyLobbyDetails(lobby))
+	}
+	s.queueServerUpdatesToLobby(ctx, lobby,
+		s.createLobbyReadyStateUpdate(lobby),
+		s.createPlayQueueUpdate(lobby),
 	)
-
-	return nil
 }
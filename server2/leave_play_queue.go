@@ -0,0 +1,54 @@
+package server2
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+)
+
+func (s *Server) leavePlayQueue(ctx context.Context, clientId string) error {
+	player, outcome := s.validatePlayer(ctx, clientId)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createLeavePlayQueueReply(outcome))
+		return nil
+	}
+
+	lobby, outcome := s.getPlayerLobby(player.Id)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createLeavePlayQueueReply(outcome))
+		return nil
+	}
+
+	if !lobby.Queued(player.Id) {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createLeavePlayQueueReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.NotFound),
+			ErrorMessage: "player is not in the play queue",
+		}))
+		return nil
+	}
+
+	if game, exists := s.getActiveLobbyGame(lobby); exists {
+		if game.MoverX.Id == player.Id || game.MoverO.Id == player.Id {
+			s.queueServerUpdatesAndSignal(ctx, clientId, s.createLeavePlayQueueReply(&Outcome{
+				Ok:           false,
+				ErrorCode:    int32(codes.FailedPrecondition),
+				ErrorMessage: "player is currently in a game",
+			}))
+			return nil
+		}
+	}
+
+	if !lobby.Dequeue(player.Id) {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createLeavePlayQueueReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.NotFound),
+			ErrorMessage: "player is not in the play queue",
+		}))
+		return nil
+	}
+
+	s.queueServerUpdatesAndSignal(ctx, clientId, s.createLeavePlayQueueReply(&Outcome{Ok: true}))
+	s.queueServerUpdatesToLobby(ctx, lobby, s.createPlayQueueUpdate(lobby))
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package server2
+
+import (
+	"context"
+	"slices"
+	"txtcto/models"
+
+	"google.golang.org/grpc/codes"
+)
+
+func (s *Server) leaveTournament(ctx context.Context, clientId string, in *LeaveTournamentRequest) error {
+	player, outcome := s.validatePlayer(ctx, clientId)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createLeaveTournamentReply(outcome))
+		return nil
+	}
+
+	s.tournamentsMu.Lock()
+	defer s.tournamentsMu.Unlock()
+
+	tournament, outcome := s.getTournament(in.TournamentId)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createLeaveTournamentReply(outcome))
+		return nil
+	}
+
+	if tournament.Status != models.TournamentStatus_REGISTRATION {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createLeaveTournamentReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.FailedPrecondition),
+			ErrorMessage: "tournament registration is closed",
+		}))
+		return nil
+	}
+
+	if _, exists := tournament.GetEntrant(player.Id); !exists {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createLeaveTournamentReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.NotFound),
+			ErrorMessage: "player is not registered",
+		}))
+		return nil
+	}
+
+	tournament.Entrants = slices.DeleteFunc(tournament.Entrants, func(entrant *models.TournamentEntrant) bool {
+		return entrant.Player.Id == player.Id
+	})
+
+	s.queueServerUpdatesAndSignal(ctx, clientId, s.createLeaveTournamentReply(&Outcome{Ok: true}))
+	if !tournament.Spectators[player.Id] {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createTournamentBracketUpdate(tournament))
+	}
+	s.queueTournamentBracketUpdate(ctx, tournament)
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package server2
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/metadata"
+)
+
+// maxCorrelationIdLength fits a uuid or a hex trace id with room to spare.
+const maxCorrelationIdLength = 64
+
+// correlationIdFromContext returns the CorrelationId sent in the request
+// metadata, or a new one when the client did not send any. The id ends up in
+// logs, spans and updates to other clients, so one that is too long or has
+// anything but ASCII letters, digits and "-_.:" in it is replaced as well.
+func correlationIdFromContext(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("CorrelationId"); len(values) > 0 && validCorrelationId(values[0]) {
+			return values[0]
+		}
+	}
+	return uuid.New().String()
+}
+
+func validCorrelationId(correlationId string) bool {
+	if correlationId == "" || len(correlationId) > maxCorrelationIdLength {
+		return false
+	}
+	for _, r := range correlationId {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') && !strings.ContainsRune("-_.:", r) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) consumerName(publicKey string) string {
+	if consumer, exists := s.consumers.get(publicKey); exists {
+		return consumer.Name
+	}
+	return ""
+}
+
+type clientRequestKey struct{}
+
+// clientRequest follows one client update through its handler. Updates queued
+// while it is in the context carry its correlation id to every recipient, and
+// the outcome of the first reply to the requesting client is kept for the
+// log line and the span.
+type clientRequest struct {
+	clientId      string
+	correlationId string
+	outcome       *Outcome
+}
+
+func withClientRequest(ctx context.Context, request *clientRequest) context.Context {
+	return context.WithValue(ctx, clientRequestKey{}, request)
+}
+
+func clientRequestFromContext(ctx context.Context) (*clientRequest, bool) {
+	request, ok := ctx.Value(clientRequestKey{}).(*clientRequest)
+	return request, ok
+}
+
+// recordQueued notes the outcome of the first reply queued for the requesting
+// client.
+func (r *clientRequest) recordQueued(clientId string, updates []*ServerUpdate) {
+	if r.outcome != nil || clientId != r.clientId {
+		return
+	}
+	for _, update := range updates {
+		if outcome, exists := replyOutcome(update); exists {
+			r.outcome = outcome
+			return
+		}
+	}
+}
+
+// replyOutcome returns the outcome of the update if it is a reply. Every reply
+// names its outcome field the same way, so the generated getter is enough.
+func replyOutcome(update *ServerUpdate) (*Outcome, bool) {
+	field := update.ProtoReflect().WhichOneof(update.ProtoReflect().Descriptor().Oneofs().ByName("type"))
+	if field == nil || field.Message() == nil {
+		return nil, false
+	}
+
+	reply, ok := update.ProtoReflect().Get(field).Message().Interface().(interface{ GetOutcome() *Outcome })
+	if !ok || reply.GetOutcome() == nil {
+		return nil, false
+	}
+	return reply.GetOutcome(), true
+}
+
+func (s *Server) logClientUpdate(request *clientRequest, publicKey string, update *ClientUpdate, err error) {
+	clientId := request.clientId
+	attrs := []any{
+		slog.String("correlation_id", request.correlationId),
+		slog.String("client_id", clientId),
+		slog.String("consumer", s.consumerName(publicKey)),
+		slog.String("type", clientUpdateTypeName(update)),
+	}
+	if playerId, exists := s.clientPlayer.get(clientId); exists {
+		attrs = append(attrs, slog.String("player_id", playerId))
+	}
+
+	if err != nil {
+		s.logger.Error("client update failed", append(attrs, slog.String("error", err.Error()))...)
+		return
+	}
+
+	if outcome := request.outcome; outcome != nil {
+		attrs = append(attrs, slog.Bool("ok", outcome.Ok))
+		if !outcome.Ok {
+			attrs = append(attrs,
+				slog.Int("error_code", int(outcome.ErrorCode)),
+				slog.String("error_message", outcome.ErrorMessage),
+			)
+		}
+	}
+
+	s.logger.Info("client update", attrs...)
+}
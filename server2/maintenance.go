@@ -0,0 +1,73 @@
+package server2
+
+import (
+	"context"
+	"sync"
+	"txtcto/models"
+
+	"google.golang.org/grpc/codes"
+)
+
+const defaultMaintenanceMessage = "The server is about to go down for maintenance"
+
+// maintenanceMode turns away new games while letting games in progress
+// finish, so the server can be drained before it is taken down.
+type maintenanceMode struct {
+	mu      sync.RWMutex
+	enabled bool
+	message string
+}
+
+func (m *maintenanceMode) get() (bool, string) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.enabled, m.message
+}
+
+func (m *maintenanceMode) set(enabled bool, message string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.enabled = enabled
+	m.message = message
+}
+
+// checkMaintenance refuses to start anything new while the server is in
+// maintenance mode.
+func (s *Server) checkMaintenance() *Outcome {
+	enabled, message := s.maintenance.get()
+	if !enabled {
+		return &Outcome{Ok: true}
+	}
+
+	if message == "" {
+		message = defaultMaintenanceMessage
+	}
+
+	return &Outcome{
+		Ok:           false,
+		ErrorCode:    int32(codes.Unavailable),
+		ErrorMessage: message + ", new games can not be started",
+	}
+}
+
+// setMaintenanceMode switches maintenance mode. When it is switched off,
+// winner stays on lobbies that were held back pick up their play queues
+// again.
+func (s *Server) setMaintenanceMode(ctx context.Context, enabled bool, message string) {
+	s.maintenance.set(enabled, message)
+	if enabled {
+		return
+	}
+
+	lobbies := []*models.Lobby{}
+	s.lobbies.forEach(func(lobbyId string, lobby *models.Lobby) bool {
+		if lobby.Mode == models.LobbyMode_WINNER_STAYS_ON {
+			lobbies = append(lobbies, lobby)
+		}
+		return true
+	})
+
+	for _, lobby := range lobbies {
+		s.startNextQueuedGame(ctx, lobby)
+	}
+}
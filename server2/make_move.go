@@ -1,21 +1,22 @@
 package server2
 
 import (
+	"context"
 	"txtcto/models"
 
 	"google.golang.org/grpc/codes"
 )
 
-func (s *Server) makeMove(playerYouClientId string, in *MakeMoveRequest) error {
-	playerYou, outcome := s.validatePlayer(playerYouClientId)
+func (s *Server) makeMove(ctx context.Context, playerYouClientId string, in *MakeMoveRequest) error {
+	playerYou, outcome := s.validatePlayer(ctx, playerYouClientId)
 	if !outcome.Ok {
-		s.queueServerUpdatesAndSignal(playerYouClientId, s.createMakeMoveReply(outcome))
+		s.queueServerUpdatesAndSignal(ctx, playerYouClientId, s.createMakeMoveReply(outcome))
 		return nil
 	}
 
 	gameId, exists := s.playerGame.get(playerYou.Id)
 	if !exists {
-		s.queueServerUpdatesAndSignal(playerYouClientId, s.createMakeMoveReply(&Outcome{
+		s.queueServerUpdatesAndSignal(ctx, playerYouClientId, s.createMakeMoveReply(&Outcome{
 			Ok:           false,
 			ErrorCode:    int32(codes.NotFound),
 			ErrorMessage: "you are not in a game",
@@ -25,7 +26,7 @@ func (s *Server) makeMove(playerYouClientId string, in *MakeMoveRequest) error {
 
 	game, exists := s.games.get(gameId)
 	if !exists {
-		s.queueServerUpdatesAndSignal(playerYouClientId, s.createMakeMoveReply(&Outcome{
+		s.queueServerUpdatesAndSignal(ctx, playerYouClientId, s.createMakeMoveReply(&Outcome{
 			Ok:           false,
 			ErrorCode:    int32(codes.NotFound),
 			ErrorMessage: "your game not found",
@@ -34,7 +35,7 @@ func (s *Server) makeMove(playerYouClientId string, in *MakeMoveRequest) error {
 	}
 
 	if game.Result == models.GameResult_DRAW || game.Result == models.GameResult_WIN || game.Result == models.GameResult_WIN_BY_FORFEIT {
-		s.queueServerUpdatesAndSignal(playerYouClientId, s.createMakeMoveReply(&Outcome{
+		s.queueServerUpdatesAndSignal(ctx, playerYouClientId, s.createMakeMoveReply(&Outcome{
 			Ok:           false,
 			ErrorCode:    int32(codes.InvalidArgument),
 			ErrorMessage: "your game has already ended",
@@ -43,7 +44,7 @@ func (s *Server) makeMove(playerYouClientId string, in *MakeMoveRequest) error {
 	}
 
 	if game.MoverO.Id != playerYou.Id && game.MoverX.Id != playerYou.Id {
-		s.queueServerUpdatesAndSignal(playerYouClientId, s.createMakeMoveReply(&Outcome{
+		s.queueServerUpdatesAndSignal(ctx, playerYouClientId, s.createMakeMoveReply(&Outcome{
 			Ok:           false,
 			ErrorCode:    int32(codes.InvalidArgument),
 			ErrorMessage: "you are not a game participant",
@@ -52,7 +53,7 @@ func (s *Server) makeMove(playerYouClientId string, in *MakeMoveRequest) error {
 	}
 
 	if game.Mover.Id != playerYou.Id {
-		s.queueServerUpdatesAndSignal(playerYouClientId, s.createMakeMoveReply(&Outcome{
+		s.queueServerUpdatesAndSignal(ctx, playerYouClientId, s.createMakeMoveReply(&Outcome{
 			Ok:           false,
 			ErrorCode:    int32(codes.InvalidArgument),
 			ErrorMessage: "it is not your turn to move",
@@ -70,15 +71,39 @@ func (s *Server) makeMove(playerYouClientId string, in *MakeMoveRequest) error {
 
 	if !outcome.Ok {
 		game.Result = models.GameResult_WIN_BY_FORFEIT
-		s.queueServerUpdatesAndSignal(playerYouClientId,
+		game.Winner = playerYou
+
+		playerOther = game.MoverX
+		if playerOther.Id == playerYou.Id {
+			playerOther = game.MoverO
+		}
+
+		if s.completeManagedGame(ctx, game) {
+			outcome = &Outcome{Ok: true}
+		} else {
+			_, outcome = s.setupRematch(game, playerYou, playerOther)
+		}
+
+		s.queueServerUpdatesAndSignal(ctx, playerYouClientId,
 			s.createMakeMoveReply(&Outcome{Ok: true}),
-			s.createWinnerUpdate(s.areYouTheMover(game, playerYou), Technicality_BY_FORFEIT),
+			s.createWinnerUpdate(true, Technicality_BY_FORFEIT),
 		)
+
+		if outcome.Ok {
+			s.queueServerUpdatesAndSignal(ctx, playerYouClientId, s.getRematchCountdownUpdates(playerYou.Id)...)
+			return nil
+		}
+
+		s.playerGame.delete(playerYou.Id)
+		s.playerGame.delete(playerOther.Id)
+		s.refreshPresence(ctx, playerYou.Id, playerOther.Id)
+
+		s.queueServerUpdatesAndSignal(ctx, playerYouClientId, s.initialServerUpdates(ctx, playerYouClientId)...)
 		return nil
 	}
 
 	if in.Position < 0 || int(in.Position) >= len(game.Board) {
-		s.queueServerUpdatesAndSignal(playerYouClientId, s.createMakeMoveReply(&Outcome{
+		s.queueServerUpdatesAndSignal(ctx, playerYouClientId, s.createMakeMoveReply(&Outcome{
 			Ok:           false,
 			ErrorCode:    int32(codes.InvalidArgument),
 			ErrorMessage: "your move postiion is out of range",
@@ -87,7 +112,7 @@ func (s *Server) makeMove(playerYouClientId string, in *MakeMoveRequest) error {
 	}
 
 	if game.Board[in.Position] != "" {
-		s.queueServerUpdatesAndSignal(playerYouClientId, s.createMakeMoveReply(&Outcome{
+		s.queueServerUpdatesAndSignal(ctx, playerYouClientId, s.createMakeMoveReply(&Outcome{
 			Ok:           false,
 			ErrorCode:    int32(codes.InvalidArgument),
 			ErrorMessage: "your move is not valid because the position is already occupied",
@@ -100,26 +125,34 @@ func (s *Server) makeMove(playerYouClientId string, in *MakeMoveRequest) error {
 
 	if s.checkWin(game) {
 		game.Result = models.GameResult_WIN
+		game.Winner = playerYou
 
-		_, outcome = s.setupRematch(playerYou, playerOther)
+		if s.completeManagedGame(ctx, game) {
+			outcome = &Outcome{Ok: true}
+		} else {
+			_, outcome = s.setupRematch(game, playerYou, playerOther)
+		}
 
 		if outcome.Ok {
-			s.queueServerUpdatesAndSignal(playerYouClientId,
+			s.queueServerUpdatesAndSignal(ctx, playerYouClientId,
 				s.createMakeMoveReply(&Outcome{Ok: true}),
 				s.createMoveUpdate(game, playerYou.Id, int32(in.Position)),
 				s.createWinnerUpdate(s.areYouTheMover(game, playerYou), Technicality_NO_PROBLEM),
 			)
 
-			s.queueServerUpdatesAndSignal(playerOtherClientId,
+			s.queueServerUpdatesAndSignal(ctx, playerOtherClientId,
 				s.createMoveUpdate(game, playerYou.Id, int32(in.Position)),
 				s.createWinnerUpdate(s.areYouTheMover(game, playerOther), Technicality_NO_PROBLEM),
 			)
 
+			s.queueServerUpdatesAndSignal(ctx, playerYouClientId, s.getRematchCountdownUpdates(playerYou.Id)...)
+			s.queueServerUpdatesAndSignal(ctx, playerOtherClientId, s.getRematchCountdownUpdates(playerOther.Id)...)
+
 			return nil
 		}
 
-		s.queueServerUpdatesAndSignal(playerYouClientId, s.initialServerUpdates(playerYouClientId)...)
-		s.queueServerUpdatesAndSignal(playerOtherClientId, s.initialServerUpdates(playerOtherClientId)...)
+		s.queueServerUpdatesAndSignal(ctx, playerYouClientId, s.initialServerUpdates(ctx, playerYouClientId)...)
+		s.queueServerUpdatesAndSignal(ctx, playerOtherClientId, s.initialServerUpdates(ctx, playerOtherClientId)...)
 
 		return nil
 	}
@@ -127,41 +160,72 @@ func (s *Server) makeMove(playerYouClientId string, in *MakeMoveRequest) error {
 	if s.checkDraw(game) {
 		game.Result = models.GameResult_DRAW
 
-		_, outcome = s.setupRematch(playerYou, playerOther)
+		if s.completeManagedGame(ctx, game) {
+			outcome = &Outcome{Ok: true}
+		} else {
+			_, outcome = s.setupRematch(game, playerYou, playerOther)
+		}
 
 		if outcome.Ok {
-			s.queueServerUpdatesAndSignal(playerYouClientId,
+			s.queueServerUpdatesAndSignal(ctx, playerYouClientId,
 				s.createMakeMoveReply(&Outcome{Ok: true}),
 				s.createMoveUpdate(game, playerYou.Id, int32(in.Position)),
 				s.createDrawUpdate(),
 			)
 
-			s.queueServerUpdatesAndSignal(playerOtherClientId,
+			s.queueServerUpdatesAndSignal(ctx, playerOtherClientId,
 				s.createMoveUpdate(game, playerYou.Id, int32(in.Position)),
 				s.createDrawUpdate(),
 			)
 
+			s.queueServerUpdatesAndSignal(ctx, playerYouClientId, s.getRematchCountdownUpdates(playerYou.Id)...)
+			s.queueServerUpdatesAndSignal(ctx, playerOtherClientId, s.getRematchCountdownUpdates(playerOther.Id)...)
+
 			return nil
 		}
 
-		s.queueServerUpdatesAndSignal(playerYouClientId, s.initialServerUpdates(playerYouClientId)...)
-		s.queueServerUpdatesAndSignal(playerOtherClientId, s.initialServerUpdates(playerOtherClientId)...)
+		s.queueServerUpdatesAndSignal(ctx, playerYouClientId, s.initialServerUpdates(ctx, playerYouClientId)...)
+		s.queueServerUpdatesAndSignal(ctx, playerOtherClientId, s.initialServerUpdates(ctx, playerOtherClientId)...)
 
 		return nil
 	}
 
 	s.switchMover(game)
 
-	s.queueServerUpdatesAndSignal(playerYouClientId,
+	s.queueServerUpdatesAndSignal(ctx, playerYouClientId,
 		s.createMakeMoveReply(&Outcome{Ok: true}),
 		s.createMoveUpdate(game, playerYou.Id, int32(in.Position)),
 		s.createNextMoverUpdate(s.areYouTheMover(game, playerYou)),
 	)
 
-	s.queueServerUpdatesAndSignal(playerOtherClientId,
+	s.queueServerUpdatesAndSignal(ctx, playerOtherClientId,
 		s.createMoveUpdate(game, playerYou.Id, int32(in.Position)),
 		s.createNextMoverUpdate(s.areYouTheMover(game, playerOther)),
 	)
 
 	return nil
 }
+
+// completeManagedGame records the outcome of an ended game and hands it back
+// to the lobby play queue, the tournament or the undecided series that
+// started it. It reports false for games that should fall back to the
+// two-player rematch.
+func (s *Server) completeManagedGame(ctx context.Context, game *models.Game) bool {
+	s.observeGameOutcome(game)
+
+	if lobby, exists := s.getWinnerStaysOnLobby(game); exists {
+		s.rotatePlayQueue(lobby, game)
+		return true
+	}
+
+	if series, exists := s.getGameSeries(game); exists {
+		return s.recordSeriesResult(ctx, series, game)
+	}
+
+	if tournament, match, exists := s.getTournamentMatch(game); exists {
+		s.recordTournamentResult(ctx, tournament, match, game)
+		return true
+	}
+
+	return false
+}
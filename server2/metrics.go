@@ -0,0 +1,262 @@
+package server2
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+	"txtcto/models"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const metricsNamespace = "tctxto"
+
+// updateBacklogBuckets are the upper bounds of the update backlog histogram.
+var updateBacklogBuckets = []float64{0, 1, 5, 10, 50, 100, 500, 1000}
+
+var gameResultNames = map[models.GameResult]string{
+	models.GameResult_INITIAL:        "INITIAL",
+	models.GameResult_ONGOING:        "ONGOING",
+	models.GameResult_WIN:            "WIN",
+	models.GameResult_DRAW:           "DRAW",
+	models.GameResult_WIN_BY_FORFEIT: "WIN_BY_FORFEIT",
+}
+
+type metrics struct {
+	registry            *prometheus.Registry
+	rpcRequests         *prometheus.CounterVec
+	rpcDuration         *prometheus.HistogramVec
+	activeSubscriptions *prometheus.GaugeVec
+	clientUpdates       *prometheus.CounterVec
+	clientUpdateLatency *prometheus.HistogramVec
+	gameOutcomes        *prometheus.CounterVec
+}
+
+func newMetrics(s *Server) *metrics {
+	m := &metrics{
+		registry: prometheus.NewRegistry(),
+		rpcRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "grpc_requests_total",
+			Help:      "gRPC calls handled, by method and status code.",
+		}, []string{"method", "code"}),
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "grpc_request_duration_seconds",
+			Help:      "Duration of unary gRPC calls, by method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method"}),
+		activeSubscriptions: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "active_subscriptions",
+			Help:      "Open subscription streams, by RPC.",
+		}, []string{"rpc"}),
+		clientUpdates: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "client_updates_total",
+			Help:      "Client updates handled, by type.",
+		}, []string{"type"}),
+		clientUpdateLatency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "client_update_duration_seconds",
+			Help:      "Time spent handling a client update, by type.",
+			Buckets:   []float64{.0001, .0005, .001, .005, .01, .05, .1, .5, 1},
+		}, []string{"type"}),
+		gameOutcomes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "game_outcomes_total",
+			Help:      "Ended games and tournament matches forfeited without a game, by result and by what the game was part of.",
+		}, []string{"result", "kind"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.rpcRequests,
+		m.rpcDuration,
+		m.activeSubscriptions,
+		m.clientUpdates,
+		m.clientUpdateLatency,
+		m.gameOutcomes,
+		newStateCollector(s),
+	)
+
+	return m
+}
+
+// MetricsHandler serves the server's metrics in the Prometheus text format.
+func (s *Server) MetricsHandler() http.Handler {
+	return promhttp.HandlerFor(s.metrics.registry, promhttp.HandlerOpts{})
+}
+
+// UnaryServerInterceptor counts and times unary calls such as Notify.
+func (s *Server) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		method := rpcMethodName(info.FullMethod)
+		s.metrics.rpcDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+		s.metrics.rpcRequests.WithLabelValues(method, status.Code(err).String()).Inc()
+
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor tracks the subscription streams that are open.
+func (s *Server) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		method := rpcMethodName(info.FullMethod)
+
+		s.metrics.activeSubscriptions.WithLabelValues(method).Inc()
+		err := handler(srv, ss)
+		s.metrics.activeSubscriptions.WithLabelValues(method).Dec()
+
+		s.metrics.rpcRequests.WithLabelValues(method, status.Code(err).String()).Inc()
+
+		return err
+	}
+}
+
+func (s *Server) observeClientUpdate(update *ClientUpdate, start time.Time) {
+	updateType := clientUpdateTypeName(update)
+	s.metrics.clientUpdates.WithLabelValues(updateType).Inc()
+	s.metrics.clientUpdateLatency.WithLabelValues(updateType).Observe(time.Since(start).Seconds())
+}
+
+func (s *Server) observeGameOutcome(game *models.Game) {
+	kind := "single"
+	switch {
+	case game.TournamentId != "":
+		kind = "tournament"
+	case game.SeriesId != "":
+		kind = "series"
+	case game.LobbyId != "":
+		kind = "lobby"
+	}
+	s.metrics.gameOutcomes.WithLabelValues(gameResultNames[game.Result], kind).Inc()
+}
+
+// observeTournamentWalkover counts a tournament match decided without a game
+// because a player was not available.
+func (s *Server) observeTournamentWalkover() {
+	s.metrics.gameOutcomes.WithLabelValues(gameResultNames[models.GameResult_WIN_BY_FORFEIT], "tournament").Inc()
+}
+
+// rpcMethodName turns "/server2.TicTacToe/Notify" into "Notify".
+func rpcMethodName(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
+
+// clientUpdateTypeName names the request carried by the update, for example
+// "SignInRequest".
+func clientUpdateTypeName(update *ClientUpdate) string {
+	field := update.ProtoReflect().WhichOneof(update.ProtoReflect().Descriptor().Oneofs().ByName("type"))
+	if field == nil {
+		return "Unknown"
+	}
+	return string(field.Message().Name())
+}
+
+// stateCollector reports the size of the server state when scraped instead
+// of tracking every change to it.
+type stateCollector struct {
+	s                *Server
+	connectedClients *prometheus.Desc
+	updateBacklog    *prometheus.Desc
+	activeGames      *prometheus.Desc
+	lobbies          *prometheus.Desc
+	rematches        *prometheus.Desc
+	janitorEvictions *prometheus.Desc
+}
+
+func newStateCollector(s *Server) *stateCollector {
+	return &stateCollector{
+		s: s,
+		connectedClients: prometheus.NewDesc(metricsNamespace+"_connected_clients",
+			"Clients with an open subscription stream.", nil, nil),
+		updateBacklog: prometheus.NewDesc(metricsNamespace+"_client_update_backlog",
+			"Server updates queued for connected clients but not sent yet, per client.", nil, nil),
+		activeGames: prometheus.NewDesc(metricsNamespace+"_active_games",
+			"Games that have not ended.", nil, nil),
+		lobbies: prometheus.NewDesc(metricsNamespace+"_lobbies",
+			"Lobbies currently stored.", nil, nil),
+		rematches: prometheus.NewDesc(metricsNamespace+"_pending_rematches",
+			"Rematches waiting on a decision.", nil, nil),
+		janitorEvictions: prometheus.NewDesc(metricsNamespace+"_janitor_evictions_total",
+			"Entries evicted by the janitor, by kind.", []string{"kind"}, nil),
+	}
+}
+
+func (c *stateCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.connectedClients
+	ch <- c.updateBacklog
+	ch <- c.activeGames
+	ch <- c.lobbies
+	ch <- c.rematches
+	ch <- c.janitorEvictions
+}
+
+func (c *stateCollector) Collect(ch chan<- prometheus.Metric) {
+	s := c.s
+
+	connected := []string{}
+	s.clientSignal.forEach(func(clientId string, signal chan struct{}) bool {
+		connected = append(connected, clientId)
+		return true
+	})
+	ch <- prometheus.MustNewConstMetric(c.connectedClients, prometheus.GaugeValue, float64(len(connected)))
+
+	backlogBuckets := map[float64]uint64{}
+	backlogSum := 0
+	for _, clientId := range connected {
+		backlog := s.pendingServerUpdates(clientId)
+		backlogSum += backlog
+		for _, bound := range updateBacklogBuckets {
+			if float64(backlog) <= bound {
+				backlogBuckets[bound]++
+			}
+		}
+	}
+	ch <- prometheus.MustNewConstHistogram(c.updateBacklog, uint64(len(connected)), float64(backlogSum), backlogBuckets)
+
+	activeGames, lobbies, rematches := 0, 0, 0
+	s.games.forEach(func(gameId string, game *models.Game) bool {
+		if !game.Ended() {
+			activeGames++
+		}
+		return true
+	})
+	s.lobbies.forEach(func(lobbyId string, lobby *models.Lobby) bool {
+		lobbies++
+		return true
+	})
+	s.rematches.forEach(func(rematchId string, rematch *models.Rematch) bool {
+		rematches++
+		return true
+	})
+	ch <- prometheus.MustNewConstMetric(c.activeGames, prometheus.GaugeValue, float64(activeGames))
+	ch <- prometheus.MustNewConstMetric(c.lobbies, prometheus.GaugeValue, float64(lobbies))
+	ch <- prometheus.MustNewConstMetric(c.rematches, prometheus.GaugeValue, float64(rematches))
+
+	for kind, count := range map[string]int64{
+		"clients":          s.janitorStats.clients.Load(),
+		"games":            s.janitorStats.games.Load(),
+		"lobbies":          s.janitorStats.lobbies.Load(),
+		"rematches":        s.janitorStats.rematches.Load(),
+		"series":           s.janitorStats.series.Load(),
+		"tournaments":      s.janitorStats.tournaments.Load(),
+		"invitations":      s.janitorStats.invitations.Load(),
+		"presence":         s.janitorStats.presence.Load(),
+		"token_buckets":    s.janitorStats.tokenBuckets.Load(),
+		"sign_in_attempts": s.janitorStats.signInAttempts.Load(),
+		"announcements":    s.janitorStats.announcements.Load(),
+	} {
+		ch <- prometheus.MustNewConstMetric(c.janitorEvictions, prometheus.CounterValue, float64(count), kind)
+	}
+}
@@ -2,6 +2,7 @@ package server2
 
 import (
 	"context"
+	"time"
 
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -17,34 +18,106 @@ func (s *Server) Notify(ctx context.Context, update *ClientUpdate) (*Empty, erro
 		return nil, status.Error(codes.NotFound, "unknown client")
 	}
 
+	if outcome := s.allowClientUpdate(clientId, update); !outcome.Ok {
+		return nil, status.Error(codes.Code(outcome.ErrorCode), outcome.ErrorMessage)
+	}
+
+	publicKey, _ := s.clientConsumer.get(clientId)
+	if err := s.handleClientUpdate(ctx, clientId, publicKey, correlationIdFromContext(ctx), update); err != nil {
+		return nil, err
+	}
+
+	return &Empty{}, nil
+}
+
+func (s *Server) handleClientUpdate(ctx context.Context, clientId, publicKey, correlationId string, update *ClientUpdate) error {
+	s.touchClient(clientId)
+	defer s.observeClientUpdate(update, time.Now())
+
+	ctx, span := s.startClientUpdateSpan(ctx, clientId, correlationId, update)
+
+	request := &clientRequest{clientId: clientId, correlationId: correlationId}
+	err := s.dispatchClientUpdate(withClientRequest(ctx, request), clientId, update)
+
+	s.logClientUpdate(request, publicKey, update, err)
+	endClientUpdateSpan(span, request, err)
+
+	return err
+}
+
+func (s *Server) dispatchClientUpdate(ctx context.Context, clientId string, update *ClientUpdate) error {
+	var err error
+
 	switch update := update.Type.(type) {
 	case *ClientUpdate_SignUpRequest:
-		err = s.signUp(clientId, update.SignUpRequest)
+		err = s.signUp(ctx, clientId, update.SignUpRequest)
+	case *ClientUpdate_PlayAsGuestRequest:
+		err = s.playAsGuest(ctx, clientId)
 	case *ClientUpdate_SignOutRequest:
-		err = s.signOut(clientId)
+		err = s.signOut(ctx, clientId)
 	case *ClientUpdate_SignInRequest:
-		err = s.signIn(clientId, update.SignInRequest)
+		err = s.signIn(ctx, clientId, update.SignInRequest)
 	case *ClientUpdate_CreateLobbyRequest:
-		err = s.createLobby(clientId, update.CreateLobbyRequest)
+		err = s.createLobby(ctx, clientId, update.CreateLobbyRequest)
 	case *ClientUpdate_JoinLobbyRequest:
-		err = s.joinLobby(clientId, update.JoinLobbyRequest)
+		err = s.joinLobby(ctx, clientId, update.JoinLobbyRequest)
 	case *ClientUpdate_LeaveMyLobbyRequest:
-		err = s.leaveMyLobby(clientId)
+		err = s.leaveMyLobby(ctx, clientId)
 	case *ClientUpdate_CreateGameRequest:
-		err = s.createGame(clientId, update.CreateGameRequest)
+		err = s.createGame(ctx, clientId, update.CreateGameRequest)
 	case *ClientUpdate_MakeMoveRequest:
-		err = s.makeMove(clientId, update.MakeMoveRequest)
+		err = s.makeMove(ctx, clientId, update.MakeMoveRequest)
 	case *ClientUpdate_RematchRequest:
-		err = s.rematch(clientId, update.RematchRequest)
+		err = s.rematch(ctx, clientId, update.RematchRequest)
 	case *ClientUpdate_ChangePlayerDisplayNameRequest:
-		err = s.changePlayerDisplayName(clientId, update.ChangePlayerDisplayNameRequest)
+		err = s.changePlayerDisplayName(ctx, clientId, update.ChangePlayerDisplayNameRequest)
 	case *ClientUpdate_LobbySearchRequest:
-		err = s.searchLobby(clientId, update.LobbySearchRequest)
+		err = s.searchLobby(ctx, clientId, update.LobbySearchRequest)
+	case *ClientUpdate_InviteToLobbyRequest:
+		err = s.inviteToLobby(ctx, clientId, update.InviteToLobbyRequest)
+	case *ClientUpdate_RespondLobbyInvitationRequest:
+		err = s.respondLobbyInvitation(ctx, clientId, update.RespondLobbyInvitationRequest)
+	case *ClientUpdate_SetReadyRequest:
+		err = s.setReady(ctx, clientId, update.SetReadyRequest)
+	case *ClientUpdate_SetLobbyModeRequest:
+		err = s.setLobbyMode(ctx, clientId, update.SetLobbyModeRequest)
+	case *ClientUpdate_JoinPlayQueueRequest:
+		err = s.joinPlayQueue(ctx, clientId)
+	case *ClientUpdate_LeavePlayQueueRequest:
+		err = s.leavePlayQueue(ctx, clientId)
+	case *ClientUpdate_CreateTournamentRequest:
+		err = s.createTournament(ctx, clientId, update.CreateTournamentRequest)
+	case *ClientUpdate_JoinTournamentRequest:
+		err = s.joinTournament(ctx, clientId, update.JoinTournamentRequest)
+	case *ClientUpdate_LeaveTournamentRequest:
+		err = s.leaveTournament(ctx, clientId, update.LeaveTournamentRequest)
+	case *ClientUpdate_WatchTournamentRequest:
+		err = s.watchTournament(ctx, clientId, update.WatchTournamentRequest)
+	case *ClientUpdate_GetTournamentRequest:
+		err = s.getTournamentDetails(ctx, clientId, update.GetTournamentRequest)
+	case *ClientUpdate_AddFriendRequest:
+		err = s.addFriend(ctx, clientId, update.AddFriendRequest)
+	case *ClientUpdate_RemoveFriendRequest:
+		err = s.removeFriend(ctx, clientId, update.RemoveFriendRequest)
+	case *ClientUpdate_RespondFriendRequest:
+		err = s.respondFriendRequest(ctx, clientId, update.RespondFriendRequest)
+	case *ClientUpdate_ChallengePlayerRequest:
+		err = s.challengePlayer(ctx, clientId, update.ChallengePlayerRequest)
+	case *ClientUpdate_RespondChallengeRequest:
+		err = s.respondChallenge(ctx, clientId, update.RespondChallengeRequest)
+	case *ClientUpdate_CancelChallengeRequest:
+		err = s.cancelChallenge(ctx, clientId, update.CancelChallengeRequest)
+	case *ClientUpdate_BlockPlayerRequest:
+		err = s.blockPlayer(ctx, clientId, update.BlockPlayerRequest)
+	case *ClientUpdate_UnblockPlayerRequest:
+		err = s.unblockPlayer(ctx, clientId, update.UnblockPlayerRequest)
+	case *ClientUpdate_ChangePasswordRequest:
+		err = s.changePassword(ctx, clientId, update.ChangePasswordRequest)
+	case *ClientUpdate_ChangeUsernameRequest:
+		err = s.changeUsername(ctx, clientId, update.ChangeUsernameRequest)
+	case *ClientUpdate_DeleteAccountRequest:
+		err = s.deleteAccount(ctx, clientId, update.DeleteAccountRequest)
 	}
 
-	if err != nil {
-		return nil, err
-	}
-
-	return &Empty{}, nil
+	return err
 }
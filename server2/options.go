@@ -0,0 +1,142 @@
+package server2
+
+import (
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Defaults used when the matching option is not given.
+const (
+	DefaultRematchWindow       = 30 * time.Second
+	DefaultDisplayNameCooldown = time.Minute
+	DefaultGuestTTL            = 30 * time.Minute
+	DefaultPingInterval        = 100 * time.Millisecond
+	DefaultLobbySearchLimit    = 20
+)
+
+type Option func(*Server)
+
+// WithRematchWindow sets how long players have to decide on a rematch before
+// an undecided player is treated as having declined. A zero or negative
+// window keeps the rematch open until both players decide.
+func WithRematchWindow(window time.Duration) Option {
+	return func(s *Server) {
+		s.rematchWindow = window
+	}
+}
+
+// WithRematchSwapSides makes a rematch give each player the other side of the
+// previous game instead of flipping a coin.
+func WithRematchSwapSides(swap bool) Option {
+	return func(s *Server) {
+		s.rematchSwapSides = swap
+	}
+}
+
+// WithDisplayNameDenyList rejects display names that contain any of the given
+// words. Words are compared after normalization and confusable folding, so
+// lookalike spellings are caught as well.
+func WithDisplayNameDenyList(words []string) Option {
+	return func(s *Server) {
+		s.displayNameDenyList = words
+	}
+}
+
+// WithUniqueDisplayNames stops two players from using display names that look
+// the same.
+func WithUniqueDisplayNames(unique bool) Option {
+	return func(s *Server) {
+		s.uniqueDisplayNames = unique
+	}
+}
+
+// WithDisplayNameCooldown sets how long a player has to wait between display
+// name changes. A zero or negative cooldown allows changes at any time.
+func WithDisplayNameCooldown(cooldown time.Duration) Option {
+	return func(s *Server) {
+		s.displayNameCooldown = cooldown
+	}
+}
+
+// WithGuestTTL sets how long a guest can stay inactive before the guest and
+// everything it was part of is discarded. A zero or negative TTL keeps guests
+// until they sign out.
+func WithGuestTTL(ttl time.Duration) Option {
+	return func(s *Server) {
+		s.guestTTL = ttl
+	}
+}
+
+// WithClientRateLimit sets how many requests per second a single client may
+// send on average and how many it may send in a burst.
+func WithClientRateLimit(rate float64, burst int) Option {
+	return func(s *Server) {
+		s.clientRate = rate
+		s.clientBurst = burst
+	}
+}
+
+// WithConsumerRateLimit sets the shared request rate and burst for all the
+// clients connecting through the same consumer.
+func WithConsumerRateLimit(rate float64, burst int) Option {
+	return func(s *Server) {
+		s.consumerRate = rate
+		s.consumerBurst = burst
+	}
+}
+
+// WithJanitorInterval sets how often idle clients and orphaned state are
+// swept. A zero or negative interval disables the janitor.
+func WithJanitorInterval(interval time.Duration) Option {
+	return func(s *Server) {
+		s.janitorInterval = interval
+	}
+}
+
+// WithIdleClientTTL sets how long a client that is not subscribed may stay
+// silent before the janitor evicts it.
+func WithIdleClientTTL(ttl time.Duration) Option {
+	return func(s *Server) {
+		s.idleClientTTL = ttl
+	}
+}
+
+// WithLogger sets the logger used for request and audit logging. The default
+// is slog.Default().
+func WithLogger(logger *slog.Logger) Option {
+	return func(s *Server) {
+		s.logger = logger
+	}
+}
+
+// WithTracerProvider sets where the server's spans go. The default is the
+// global tracer provider.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(s *Server) {
+		s.tracer = provider.Tracer(tracerName)
+	}
+}
+
+// WithAnnouncementsFile keeps the active announcements in a JSON file so they
+// survive a restart. Without it announcements only live in memory.
+func WithAnnouncementsFile(path string) Option {
+	return func(s *Server) {
+		s.announcementsFile = path
+	}
+}
+
+// WithPingInterval sets how often subscribed clients are pinged.
+func WithPingInterval(interval time.Duration) Option {
+	return func(s *Server) {
+		s.pingInterval = interval
+	}
+}
+
+// WithLobbySearchLimit caps the number of lobbies a lobby search returns.
+func WithLobbySearchLimit(limit int) Option {
+	return func(s *Server) {
+		s.lobbySearchLimit = limit
+	}
+}
@@ -0,0 +1,87 @@
+package server2
+
+import (
+	"context"
+	"time"
+	"txtcto/models"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+)
+
+func (s *Server) playAsGuest(ctx context.Context, clientId string) error {
+	if _, exists := s.clientPlayer.get(clientId); exists {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createPlayAsGuestReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.FailedPrecondition),
+			ErrorMessage: "client is already signed in",
+		}))
+		return nil
+	}
+
+	player := &models.Player{
+		Id:           uuid.New().String(),
+		Guest:        true,
+		LastActiveAt: time.Now(),
+	}
+
+	s.accountsMu.Lock()
+	displayName, outcome := s.claimGeneratedDisplayName(player.Id, "guest", 8)
+	s.accountsMu.Unlock()
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createPlayAsGuestReply(outcome))
+		return nil
+	}
+	player.DisplayName = displayName
+
+	s.players.set(player.Id, player)
+	s.playerClient.set(player.Id, clientId)
+	s.clientPlayer.set(clientId, player.Id)
+	s.refreshPresence(ctx, player.Id)
+
+	s.scheduleGuestExpiry(player.Id, s.guestTTL)
+
+	s.queueServerUpdatesAndSignal(ctx, clientId,
+		s.createPlayAsGuestReply(&Outcome{Ok: true}),
+		s.createNavigationUpdate(NavigationPath_HOME),
+		s.createPlayerDisplayNameUpdate(player.DisplayName),
+	)
+
+	return nil
+}
+
+// scheduleGuestExpiry purges a guest once it has been inactive for the guest
+// TTL. Activity in the meantime pushes the check back instead. Guests that
+// have signed up in the meantime are left alone.
+func (s *Server) scheduleGuestExpiry(playerId string, after time.Duration) {
+	if s.guestTTL <= 0 {
+		return
+	}
+
+	time.AfterFunc(after, func() {
+		player, exists := s.players.get(playerId)
+		if !exists || !player.Guest {
+			return
+		}
+
+		if idle := time.Since(player.LastActiveAt); idle < s.guestTTL {
+			s.scheduleGuestExpiry(playerId, s.guestTTL-idle)
+			return
+		}
+
+		s.purgePlayer(context.Background(), player, "", "Your guest session has expired")
+	})
+}
+
+// validateNotGuest is used by actions that only make sense for players with
+// an account.
+func (s *Server) validateNotGuest(player *models.Player) *Outcome {
+	if player.Guest {
+		return &Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.FailedPrecondition),
+			ErrorMessage: "guests need to sign up first",
+		}
+	}
+	return &Outcome{Ok: true}
+}
@@ -0,0 +1,142 @@
+package server2
+
+import (
+	"context"
+	"time"
+	"txtcto/models"
+)
+
+const winnerStaysOnDelay = 3 * time.Second
+
+func (s *Server) getActiveLobbyGame(lobby *models.Lobby) (*models.Game, bool) {
+	if lobby.GameId == "" {
+		return nil, false
+	}
+
+	game, exists := s.games.get(lobby.GameId)
+	if !exists {
+		return nil, false
+	}
+
+	for _, player := range []*models.Player{game.MoverX, game.MoverO} {
+		if gameId, exists := s.playerGame.get(player.Id); exists && gameId == game.Id {
+			return game, true
+		}
+	}
+
+	return nil, false
+}
+
+func (s *Server) getWinnerStaysOnLobby(game *models.Game) (*models.Lobby, bool) {
+	if game.LobbyId == "" {
+		return nil, false
+	}
+
+	lobby, exists := s.lobbies.get(game.LobbyId)
+	if !exists || lobby.Mode != models.LobbyMode_WINNER_STAYS_ON || lobby.GameId != game.Id {
+		return nil, false
+	}
+
+	return lobby, true
+}
+
+// rotatePlayQueue keeps the winner at the front of the queue and sends the
+// loser to the back. A draw sends both players to the back. The next game is
+// started after a short delay so that both players can see the result.
+func (s *Server) rotatePlayQueue(lobby *models.Lobby, game *models.Game) {
+	players := []*models.Player{game.MoverX, game.MoverO}
+
+	winnerId := ""
+	if game.Winner != nil {
+		winnerId = game.Winner.Id
+	}
+	lobby.RotatePlayQueue([]string{game.MoverX.Id, game.MoverO.Id}, winnerId)
+
+	time.AfterFunc(winnerStaysOnDelay, func() {
+		ctx := context.Background()
+
+		for _, player := range players {
+			if gameId, exists := s.playerGame.get(player.Id); exists && gameId == game.Id {
+				s.playerGame.delete(player.Id)
+			}
+		}
+		s.refreshPresence(ctx, game.MoverX.Id, game.MoverO.Id)
+
+		// The lobby may have been closed or switched back to the standard
+		// mode while the result was shown.
+		var next *models.Game
+		if current, exists := s.lobbies.get(lobby.Id); exists && current == lobby && lobby.Mode == models.LobbyMode_WINNER_STAYS_ON {
+			next = s.startNextQueuedGame(ctx, lobby)
+			if next == nil {
+				s.queueServerUpdatesToLobby(ctx, lobby, s.createPlayQueueUpdate(lobby))
+			}
+		}
+
+		for _, player := range players {
+			if next != nil && (next.MoverX.Id == player.Id || next.MoverO.Id == player.Id) {
+				continue
+			}
+			if clientId, exists := s.playerClient.get(player.Id); exists {
+				s.queueServerUpdatesAndSignal(ctx, clientId, s.initialServerUpdates(ctx, clientId)...)
+			}
+		}
+	})
+}
+
+func (s *Server) startNextQueuedGame(ctx context.Context, lobby *models.Lobby) *models.Game {
+	if lobby.Mode != models.LobbyMode_WINNER_STAYS_ON {
+		return nil
+	}
+
+	if outcome := s.checkMaintenance(); !outcome.Ok {
+		return nil
+	}
+
+	if _, exists := s.getActiveLobbyGame(lobby); exists {
+		return nil
+	}
+
+	players := []*models.Player{}
+	clientIds := []string{}
+	for _, playerId := range lobby.PlayQueue() {
+		if _, exists := s.playerGame.get(playerId); exists {
+			continue
+		}
+		clientId, player, outcome := s.getClientIdAndPlayer(playerId, "queued player")
+		if !outcome.Ok {
+			continue
+		}
+		if len(players) == 1 && s.isBlocked(players[0].Id, player.Id) {
+			continue
+		}
+		players = append(players, player)
+		clientIds = append(clientIds, clientId)
+		if len(players) == 2 {
+			break
+		}
+	}
+
+	if len(players) < 2 {
+		return nil
+	}
+
+	game, outcome := s.setupGame(ctx, lobby.Creator, players[0], players[1])
+	if !outcome.Ok {
+		return nil
+	}
+
+	game.LobbyId = lobby.Id
+	lobby.GameId = game.Id
+
+	for i, player := range players {
+		s.queueServerUpdatesAndSignal(ctx, clientIds[i],
+			s.createNavigationUpdate(NavigationPath_GAME),
+			s.createGameStartUpdate(game, player),
+			s.createNextMoverUpdate(s.areYouTheMover(game, player)),
+		)
+	}
+
+	s.queueServerUpdatesToLobby(ctx, lobby, s.createPlayQueueUpdate(lobby))
+
+	return game
+}
@@ -0,0 +1,65 @@
+package server2
+
+import (
+	"context"
+
+	"txtcto/models"
+)
+
+func (s *Server) getPresence(playerId string) Presence {
+	clientId, exists := s.playerClient.get(playerId)
+	if !exists {
+		return Presence_OFFLINE
+	}
+
+	if _, connected := s.clientSignal.get(clientId); !connected {
+		return Presence_OFFLINE
+	}
+
+	if _, exists := s.playerGame.get(playerId); exists {
+		return Presence_IN_GAME
+	}
+
+	if _, exists := s.playerLobby.get(playerId); exists {
+		return Presence_IN_LOBBY
+	}
+
+	return Presence_ONLINE
+}
+
+// refreshPresence pushes a FriendPresenceUpdate to the friends of each player
+// whose presence changed since it was last pushed. A player with no pushed
+// presence counts as offline.
+func (s *Server) refreshPresence(ctx context.Context, playerIds ...string) {
+	for _, playerId := range playerIds {
+		player, exists := s.players.get(playerId)
+		if !exists {
+			continue
+		}
+
+		presence := s.getPresence(playerId)
+		last, exists := s.playerPresence.get(playerId)
+		if !exists {
+			last = Presence_OFFLINE
+		}
+		if last == presence {
+			continue
+		}
+		s.playerPresence.set(playerId, presence)
+
+		update := s.createFriendPresenceUpdate(player, presence)
+		for friendId := range player.Friends {
+			if friendClientId, exists := s.playerClient.get(friendId); exists {
+				s.queueServerUpdatesAndSignal(ctx, friendClientId, update)
+			}
+		}
+	}
+}
+
+func (s *Server) queueFriendListUpdate(ctx context.Context, players ...*models.Player) {
+	for _, player := range players {
+		if clientId, exists := s.playerClient.get(player.Id); exists {
+			s.queueServerUpdatesAndSignal(ctx, clientId, s.createFriendListUpdate(player))
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package server2
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// The default rate limits allow this many requests per second per client
+	// and per consumer, with bursts of up to the burst.
+	DefaultClientRate     = 10
+	DefaultClientBurst    = 20
+	DefaultConsumerRate   = 200
+	DefaultConsumerBurst  = 400
+	rateLimitStrikes      = 20
+	rateLimitStrikeWindow = time.Minute
+)
+
+// tokenBucket refills at rate tokens per second up to burst tokens.
+type tokenBucket struct {
+	mu       sync.Mutex
+	rate     float64
+	burst    float64
+	tokens   float64
+	last     time.Time
+	strikes  int
+	struckAt time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// takeAll removes cost tokens from every bucket, but only when each of them
+// has enough, so a refusal by one bucket does not use up the others. Every
+// bucket that refuses counts a strike; strikes older than the strike window
+// are forgotten. The number of recent strikes of the first bucket is returned
+// alongside. Buckets are locked in the order given.
+func takeAll(cost float64, now time.Time, buckets ...*tokenBucket) (bool, int) {
+	for _, b := range buckets {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+	}
+
+	allowed := true
+	for _, b := range buckets {
+		if b.tokens >= cost {
+			continue
+		}
+
+		allowed = false
+		if now.Sub(b.struckAt) > rateLimitStrikeWindow {
+			b.strikes = 0
+		}
+		b.strikes++
+		b.struckAt = now
+	}
+
+	if allowed {
+		for _, b := range buckets {
+			b.tokens -= cost
+		}
+	}
+
+	return allowed, buckets[0].strikes
+}
+
+// clientUpdateCost weighs requests by how much work they cause. Anything that
+// scans shared state or creates long lived objects costs more than a move.
+func clientUpdateCost(update *ClientUpdate) float64 {
+	switch update.Type.(type) {
+	case *ClientUpdate_LobbySearchRequest:
+		return 5
+	case *ClientUpdate_SignInRequest, *ClientUpdate_SignUpRequest, *ClientUpdate_PlayAsGuestRequest:
+		return 3
+	case *ClientUpdate_CreateLobbyRequest, *ClientUpdate_CreateTournamentRequest, *ClientUpdate_ChangePlayerDisplayNameRequest:
+		return 3
+	case *ClientUpdate_GetTournamentRequest, *ClientUpdate_InviteToLobbyRequest, *ClientUpdate_ChallengePlayerRequest, *ClientUpdate_AddFriendRequest:
+		return 2
+	}
+	return 1
+}
+
+// allowClientUpdate charges the update against the client's bucket and the
+// bucket of the consumer the client subscribed through, and only when both
+// have room. Updates from a client that has not subscribed through a known
+// consumer are refused without creating a bucket for it. Clients that keep
+// hitting the limit are disconnected.
+func (s *Server) allowClientUpdate(clientId string, update *ClientUpdate) *Outcome {
+	publicKey, exists := s.clientConsumer.get(clientId)
+	if exists {
+		_, exists = s.consumers.get(publicKey)
+	}
+	if !exists {
+		return &Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.PermissionDenied),
+			ErrorMessage: "rejected",
+		}
+	}
+
+	clientBucket := s.getTokenBucket(s.clientBuckets, clientId, s.clientRate, s.clientBurst)
+	consumerBucket := s.getTokenBucket(s.consumerBuckets, publicKey, s.consumerRate, s.consumerBurst)
+	allowed, strikes := takeAll(clientUpdateCost(update), time.Now(), clientBucket, consumerBucket)
+
+	if allowed {
+		return &Outcome{Ok: true}
+	}
+
+	if strikes >= rateLimitStrikes {
+		s.logger.Warn("rate limit: disconnecting client", slog.String("client_id", clientId), slog.Int("strikes", strikes))
+		s.disconnectClient(clientId)
+	}
+
+	return &Outcome{
+		Ok:           false,
+		ErrorCode:    int32(codes.ResourceExhausted),
+		ErrorMessage: "too many requests, slow down",
+	}
+}
+
+func (s *Server) getTokenBucket(buckets *safeMap[string, *tokenBucket], key string, rate float64, burst int) *tokenBucket {
+	s.bucketsMu.Lock()
+	defer s.bucketsMu.Unlock()
+
+	bucket, exists := buckets.get(key)
+	if !exists {
+		bucket = newTokenBucket(rate, burst)
+		buckets.set(key, bucket)
+	}
+	return bucket
+}
+
+// sweepTokenBuckets forgets buckets that have refilled and have no recent
+// strikes, since a new bucket would start out the same. Buckets of consumers
+// that are no longer known go too.
+func (s *Server) sweepTokenBuckets(now time.Time) int {
+	s.bucketsMu.Lock()
+	defer s.bucketsMu.Unlock()
+
+	evicted := 0
+	for _, buckets := range []*safeMap[string, *tokenBucket]{s.clientBuckets, s.consumerBuckets} {
+		idle := []string{}
+		buckets.forEach(func(key string, bucket *tokenBucket) bool {
+			if buckets == s.consumerBuckets {
+				if _, exists := s.consumers.get(key); !exists {
+					idle = append(idle, key)
+					return true
+				}
+			}
+			if bucket.idle(now) {
+				idle = append(idle, key)
+			}
+			return true
+		})
+		for _, key := range idle {
+			buckets.delete(key)
+		}
+		evicted += len(idle)
+	}
+
+	return evicted
+}
+
+// idle reports whether the bucket is full again and its strikes have run
+// out.
+func (b *tokenBucket) idle(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	full := b.tokens+now.Sub(b.last).Seconds()*b.rate >= b.burst
+	return full && now.Sub(b.struckAt) > rateLimitStrikeWindow
+}
+
+// disconnectClient ends the client's subscription stream, if it has one.
+func (s *Server) disconnectClient(clientId string) {
+	if disconnect, exists := s.clientDisconnect.get(clientId); exists {
+		select {
+		case disconnect <- struct{}{}:
+		default:
+		}
+	}
+}
@@ -1,22 +1,24 @@
 package server2
 
 import (
+	"context"
+	"time"
 	"txtcto/models"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 )
 
-func (s *Server) rematch(youClientId string, in *RematchRequest) error {
-	you, outcome := s.validatePlayer(youClientId)
+func (s *Server) rematch(ctx context.Context, youClientId string, in *RematchRequest) error {
+	you, outcome := s.validatePlayer(ctx, youClientId)
 	if !outcome.Ok {
-		s.queueServerUpdatesAndSignal(youClientId, s.createRematchReply(outcome))
+		s.queueServerUpdatesAndSignal(ctx, youClientId, s.createRematchReply(outcome))
 		return nil
 	}
 
 	rematchId, exists := s.playerRematch.get(you.Id)
 	if !exists {
-		s.queueServerUpdatesAndSignal(youClientId, s.createRematchReply(&Outcome{
+		s.queueServerUpdatesAndSignal(ctx, youClientId, s.createRematchReply(&Outcome{
 			Ok:           false,
 			ErrorCode:    int32(codes.NotFound),
 			ErrorMessage: "rematch not found",
@@ -26,7 +28,7 @@ func (s *Server) rematch(youClientId string, in *RematchRequest) error {
 
 	rematch, exists := s.rematches.get(rematchId)
 	if !exists {
-		s.queueServerUpdatesAndSignal(youClientId, s.createRematchReply(&Outcome{
+		s.queueServerUpdatesAndSignal(ctx, youClientId, s.createRematchReply(&Outcome{
 			Ok:           false,
 			ErrorCode:    int32(codes.NotFound),
 			ErrorMessage: "rematch details not found",
@@ -36,7 +38,7 @@ func (s *Server) rematch(youClientId string, in *RematchRequest) error {
 
 	_, exists = rematch.GetPlayerDecision(you.Id)
 	if !exists {
-		s.queueServerUpdatesAndSignal(youClientId, s.createRematchReply(&Outcome{
+		s.queueServerUpdatesAndSignal(ctx, youClientId, s.createRematchReply(&Outcome{
 			Ok:           false,
 			ErrorCode:    int32(codes.NotFound),
 			ErrorMessage: "you are not one of the participants for the rematch",
@@ -45,6 +47,10 @@ func (s *Server) rematch(youClientId string, in *RematchRequest) error {
 	}
 
 	if in.Yes {
+		if outcome := s.checkMaintenance(); !outcome.Ok {
+			s.queueServerUpdatesAndSignal(ctx, youClientId, s.createRematchReply(outcome))
+			return nil
+		}
 		rematch.SetPlayerDecision(you.Id, models.Decision_YES)
 	} else {
 		rematch.SetPlayerDecision(you.Id, models.Decision_NO)
@@ -59,7 +65,7 @@ func (s *Server) rematch(youClientId string, in *RematchRequest) error {
 
 	otherClientId, exists := s.playerClient.get(other.Id)
 	if !exists {
-		s.queueServerUpdatesAndSignal(youClientId, s.createRematchReply(&Outcome{
+		s.queueServerUpdatesAndSignal(ctx, youClientId, s.createRematchReply(&Outcome{
 			Ok:           false,
 			ErrorCode:    int32(codes.NotFound),
 			ErrorMessage: "the other player has no client",
@@ -67,37 +73,40 @@ func (s *Server) rematch(youClientId string, in *RematchRequest) error {
 		return nil
 	}
 
-	game, updates := s.evaluateRematch(rematch)
+	game, updates := s.evaluateRematch(ctx, rematch)
 
-	s.queueServerUpdatesAndSignal(youClientId, s.createRematchReply(&Outcome{Ok: true}))
-	s.queueServerUpdatesAndSignal(youClientId, updates...)
-	s.queueServerUpdatesAndSignal(otherClientId, updates...)
+	s.queueServerUpdatesAndSignal(ctx, youClientId, s.createRematchReply(&Outcome{Ok: true}))
+	s.queueServerUpdatesAndSignal(ctx, youClientId, updates...)
+	s.queueServerUpdatesAndSignal(ctx, otherClientId, updates...)
 
 	if game != nil {
-		s.queueServerUpdatesAndSignal(youClientId,
+		s.queueServerUpdatesAndSignal(ctx, youClientId,
 			s.createNavigationUpdate(NavigationPath_GAME),
 			s.createGameStartUpdate(game, you),
 			s.createNextMoverUpdate(s.areYouTheMover(game, you)),
 		)
+		s.queueServerUpdatesAndSignal(ctx, youClientId, s.getSeriesUpdates(game, you)...)
 
-		s.queueServerUpdatesAndSignal(otherClientId,
+		s.queueServerUpdatesAndSignal(ctx, otherClientId,
 			s.createNavigationUpdate(NavigationPath_GAME),
 			s.createGameStartUpdate(game, other),
 			s.createNextMoverUpdate(s.areYouTheMover(game, other)),
 		)
+		s.queueServerUpdatesAndSignal(ctx, otherClientId, s.getSeriesUpdates(game, other)...)
 
 		return nil
 	}
 
 	if rematch.Pending() {
-		s.queueServerUpdatesAndSignal(youClientId, s.createNavigationUpdate(NavigationPath_REMATCH))
+		s.queueServerUpdatesAndSignal(ctx, youClientId, s.createNavigationUpdate(NavigationPath_REMATCH))
+		s.queueServerUpdatesAndSignal(ctx, youClientId, s.getRematchCountdownUpdates(you.Id)...)
 
 		return nil
 	}
 
 	if rematch.Cancelled() {
-		s.queueServerUpdatesAndSignal(youClientId, s.initialServerUpdates(youClientId)...)
-		s.queueServerUpdatesAndSignal(otherClientId, s.initialServerUpdates(otherClientId)...)
+		s.queueServerUpdatesAndSignal(ctx, youClientId, s.initialServerUpdates(ctx, youClientId)...)
+		s.queueServerUpdatesAndSignal(ctx, otherClientId, s.initialServerUpdates(ctx, otherClientId)...)
 
 		return nil
 	}
@@ -105,13 +114,20 @@ func (s *Server) rematch(youClientId string, in *RematchRequest) error {
 	return nil
 }
 
-func (s *Server) evaluateRematch(rematch *models.Rematch) (*models.Game, []*ServerUpdate) {
+func (s *Server) evaluateRematch(ctx context.Context, rematch *models.Rematch) (*models.Game, []*ServerUpdate) {
+	// A player who blocked the other since the game ended declines for both.
+	if rematch.Confirmed() && s.isBlocked(rematch.PlayerDecisions[0].Player.Id, rematch.PlayerDecisions[1].Player.Id) {
+		rematch.SetPlayerDecision(rematch.PlayerDecisions[0].Player.Id, models.Decision_NO)
+		rematch.SetPlayerDecision(rematch.PlayerDecisions[1].Player.Id, models.Decision_NO)
+	}
+
 	if rematch.Cancelled() {
 		for _, pd := range rematch.PlayerDecisions {
 			s.playerRematch.delete(pd.Player.Id)
 			s.playerGame.delete(pd.Player.Id)
 		}
 		s.rematches.delete(rematch.Id)
+		s.refreshPresence(ctx, rematch.PlayerDecisions[0].Player.Id, rematch.PlayerDecisions[1].Player.Id)
 
 		return nil, []*ServerUpdate{s.createRematchDenied()}
 	}
@@ -122,7 +138,7 @@ func (s *Server) evaluateRematch(rematch *models.Rematch) (*models.Game, []*Serv
 			s.playerGame.delete(pd.Player.Id)
 		}
 		s.rematches.delete(rematch.Id)
-		game, outcome := s.setupGame(
+		game, outcome := s.setupGame(ctx,
 			rematch.PlayerDecisions[0].Player,
 			rematch.PlayerDecisions[0].Player,
 			rematch.PlayerDecisions[1].Player,
@@ -132,6 +148,10 @@ func (s *Server) evaluateRematch(rematch *models.Rematch) (*models.Game, []*Serv
 			rematch.SetPlayerDecision(rematch.PlayerDecisions[1].Player.Id, models.Decision_NO)
 			return nil, []*ServerUpdate{s.createRematchDenied()}
 		}
+		if previous, exists := s.games.get(rematch.GameId); exists && s.rematchSwapSides {
+			s.swapSides(game, previous)
+		}
+		s.setupSeries(game, rematch.BestOf)
 		return game, []*ServerUpdate{s.createRematchApproved()}
 	}
 
@@ -142,7 +162,7 @@ func (s *Server) evaluateRematch(rematch *models.Rematch) (*models.Game, []*Serv
 	return nil, []*ServerUpdate{}
 }
 
-func (s *Server) setupRematch(you, other *models.Player) (*models.Rematch, *Outcome) {
+func (s *Server) setupRematch(game *models.Game, you, other *models.Player) (*models.Rematch, *Outcome) {
 	rematchId := uuid.New().String()
 
 	if _, exists := s.rematches.get(rematchId); exists {
@@ -155,6 +175,7 @@ func (s *Server) setupRematch(you, other *models.Player) (*models.Rematch, *Outc
 
 	rematch := &models.Rematch{
 		Id:              rematchId,
+		GameId:          game.Id,
 		PlayerDecisions: [2]*models.PlayerDecision{},
 	}
 
@@ -168,9 +189,72 @@ func (s *Server) setupRematch(you, other *models.Player) (*models.Rematch, *Outc
 		Decision: models.Decision_UNDECIDED,
 	}
 
+	if series, exists := s.getGameSeries(game); exists {
+		rematch.BestOf = series.BestOf
+		s.series.delete(series.Id)
+	}
+
 	s.playerRematch.set(you.Id, rematch.Id)
 	s.playerRematch.set(other.Id, rematch.Id)
 	s.rematches.set(rematch.Id, rematch)
 
+	if s.rematchWindow > 0 {
+		rematch.ExpiresAt = time.Now().Add(s.rematchWindow)
+		time.AfterFunc(s.rematchWindow, func() {
+			s.expireRematch(context.Background(), rematch.Id)
+		})
+	}
+
 	return rematch, &Outcome{Ok: true}
 }
+
+// expireRematch treats every undecided player as having declined and sends
+// both players back to where they were before the game.
+func (s *Server) expireRematch(ctx context.Context, rematchId string) {
+	rematch, exists := s.rematches.get(rematchId)
+	if !exists {
+		return
+	}
+
+	for _, pd := range rematch.PlayerDecisions {
+		if pd.Decision == models.Decision_UNDECIDED {
+			pd.Decision = models.Decision_NO
+		}
+	}
+
+	_, updates := s.evaluateRematch(ctx, rematch)
+
+	for _, pd := range rematch.PlayerDecisions {
+		if clientId, exists := s.playerClient.get(pd.Player.Id); exists {
+			s.queueServerUpdatesAndSignal(ctx, clientId, updates...)
+			s.queueServerUpdatesAndSignal(ctx, clientId, s.initialServerUpdates(ctx, clientId)...)
+		}
+	}
+}
+
+func (s *Server) getRematchCountdownUpdates(playerId string) []*ServerUpdate {
+	rematchId, exists := s.playerRematch.get(playerId)
+	if !exists {
+		return []*ServerUpdate{}
+	}
+
+	rematch, exists := s.rematches.get(rematchId)
+	if !exists || rematch.ExpiresAt.IsZero() {
+		return []*ServerUpdate{}
+	}
+
+	return []*ServerUpdate{s.createRematchCountdownUpdate(rematch.ExpiresAt)}
+}
+
+// swapSides gives each player of a rematch the side their opponent had in the
+// previous game. The side that moved first keeps moving first.
+func (s *Server) swapSides(game *models.Game, previous *models.Game) {
+	game.MoverX = previous.MoverO
+	game.MoverO = previous.MoverX
+	if previous.FirstMover.Id == previous.MoverX.Id {
+		game.Mover = game.MoverX
+	} else {
+		game.Mover = game.MoverO
+	}
+	game.FirstMover = game.Mover
+}
@@ -0,0 +1,36 @@
+package server2
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+)
+
+func (s *Server) removeFriend(ctx context.Context, clientId string, in *RemoveFriendRequest) error {
+	player, outcome := s.validatePlayer(ctx, clientId)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createRemoveFriendReply(outcome))
+		return nil
+	}
+
+	if !player.Friends[in.PlayerId] && !player.OutgoingFriendRequests[in.PlayerId] {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createRemoveFriendReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.NotFound),
+			ErrorMessage: "player is not your friend",
+		}))
+		return nil
+	}
+
+	player.RemoveFriend(in.PlayerId)
+
+	s.queueServerUpdatesAndSignal(ctx, clientId, s.createRemoveFriendReply(&Outcome{Ok: true}))
+	s.queueFriendListUpdate(ctx, player)
+
+	if other, exists := s.players.get(in.PlayerId); exists {
+		other.RemoveFriend(player.Id)
+		s.queueFriendListUpdate(ctx, other)
+	}
+
+	return nil
+}
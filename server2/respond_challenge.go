@@ -0,0 +1,118 @@
+package server2
+
+import (
+	"context"
+	"time"
+	"txtcto/models"
+
+	"google.golang.org/grpc/codes"
+)
+
+func (s *Server) respondChallenge(ctx context.Context, clientId string, in *RespondChallengeRequest) error {
+	challengee, outcome := s.validatePlayer(ctx, clientId)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createRespondChallengeReply(outcome))
+		return nil
+	}
+
+	challenge, exists := s.challenges.get(in.ChallengeId)
+	if !exists || challenge.Challengee.Id != challengee.Id {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createRespondChallengeReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.NotFound),
+			ErrorMessage: "challenge not found",
+		}))
+		return nil
+	}
+
+	if challenge.Expired(time.Now()) {
+		s.closeChallenge(ctx, challenge, models.InvitationStatus_EXPIRED)
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createRespondChallengeReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.DeadlineExceeded),
+			ErrorMessage: "challenge has expired",
+		}))
+		return nil
+	}
+
+	if !in.Accept {
+		s.closeChallenge(ctx, challenge, models.InvitationStatus_DECLINED)
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createRespondChallengeReply(&Outcome{Ok: true}))
+		return nil
+	}
+
+	if outcome := s.checkMaintenance(); !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createRespondChallengeReply(outcome))
+		return nil
+	}
+
+	challengerClientId, challenger, outcome := s.getClientIdAndPlayer(challenge.Challenger.Id, "challenger")
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createRespondChallengeReply(outcome))
+		return nil
+	}
+
+	if _, exists := s.playerGame.get(challenger.Id); exists {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createRespondChallengeReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.FailedPrecondition),
+			ErrorMessage: "challenger is currently in a game",
+		}))
+		return nil
+	}
+
+	if _, exists := s.playerGame.get(challengee.Id); exists {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createRespondChallengeReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.FailedPrecondition),
+			ErrorMessage: "you are currently in a game",
+		}))
+		return nil
+	}
+
+	if _, exists := s.challenges.get(challenge.Id); !exists || challenge.Status != models.InvitationStatus_PENDING {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createRespondChallengeReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.NotFound),
+			ErrorMessage: "challenge not found",
+		}))
+		return nil
+	}
+
+	game, outcome := s.setupGame(ctx, challenger, challenger, challengee)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createRespondChallengeReply(outcome))
+		return nil
+	}
+
+	// The challenge is only closed once the game exists, so a failed setup
+	// leaves it open. If it was withdrawn or expired in the meantime, the
+	// game is dropped again.
+	if !s.closeChallenge(ctx, challenge, models.InvitationStatus_ACCEPTED) {
+		s.discardGame(ctx, game)
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createRespondChallengeReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.NotFound),
+			ErrorMessage: "challenge not found",
+		}))
+		return nil
+	}
+
+	s.setupSeries(game, challenge.BestOf)
+
+	s.queueServerUpdatesAndSignal(ctx, clientId, s.createRespondChallengeReply(&Outcome{Ok: true}))
+	s.queueServerUpdatesAndSignal(ctx, challengerClientId,
+		s.createNavigationUpdate(NavigationPath_GAME),
+		s.createGameStartUpdate(game, challenger),
+		s.createNextMoverUpdate(s.areYouTheMover(game, challenger)),
+	)
+	s.queueServerUpdatesAndSignal(ctx, challengerClientId, s.getSeriesUpdates(game, challenger)...)
+	s.queueServerUpdatesAndSignal(ctx, clientId,
+		s.createNavigationUpdate(NavigationPath_GAME),
+		s.createGameStartUpdate(game, challengee),
+		s.createNextMoverUpdate(s.areYouTheMover(game, challengee)),
+	)
+	s.queueServerUpdatesAndSignal(ctx, clientId, s.getSeriesUpdates(game, challengee)...)
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package server2
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+)
+
+func (s *Server) respondFriendRequest(ctx context.Context, clientId string, in *RespondFriendRequest) error {
+	player, outcome := s.validatePlayer(ctx, clientId)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createRespondFriendReply(outcome))
+		return nil
+	}
+
+	if !player.IncomingFriendRequests[in.PlayerId] {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createRespondFriendReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.NotFound),
+			ErrorMessage: "friend request not found",
+		}))
+		return nil
+	}
+
+	other, exists := s.players.get(in.PlayerId)
+	if !exists {
+		player.RemoveFriend(in.PlayerId)
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createRespondFriendReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.NotFound),
+			ErrorMessage: "player not found",
+		}))
+		return nil
+	}
+
+	if in.Accept {
+		player.AddFriend(other.Id)
+		other.AddFriend(player.Id)
+	} else {
+		player.RemoveFriend(other.Id)
+		other.RemoveFriend(player.Id)
+	}
+
+	s.queueServerUpdatesAndSignal(ctx, clientId, s.createRespondFriendReply(&Outcome{Ok: true}))
+	s.queueFriendListUpdate(ctx, player, other)
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package server2
+
+import (
+	"context"
+	"time"
+	"txtcto/models"
+
+	"google.golang.org/grpc/codes"
+)
+
+func (s *Server) respondLobbyInvitation(ctx context.Context, clientId string, in *RespondLobbyInvitationRequest) error {
+	invitee, outcome := s.validatePlayer(ctx, clientId)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createRespondLobbyInvitationReply(outcome))
+		return nil
+	}
+
+	invitation, exists := s.lobbyInvitations.get(in.InvitationId)
+	if !exists || invitation.Invitee.Id != invitee.Id {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createRespondLobbyInvitationReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.NotFound),
+			ErrorMessage: "invitation not found",
+		}))
+		return nil
+	}
+
+	// The invitation is checked and settled under one lock, so it can not
+	// expire or be withdrawn while the invitee joins the lobby.
+	s.lobbyInvitationsMu.Lock()
+	defer s.lobbyInvitationsMu.Unlock()
+
+	if _, exists := s.lobbyInvitations.get(invitation.Id); !exists || invitation.Status != models.InvitationStatus_PENDING || invitation.Expired(time.Now()) {
+		if s.closeLobbyInvitation(invitation, models.InvitationStatus_EXPIRED) {
+			s.queueLobbyInvitationResponseUpdate(ctx, invitation)
+		}
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createRespondLobbyInvitationReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.DeadlineExceeded),
+			ErrorMessage: "invitation has expired",
+		}))
+		return nil
+	}
+
+	var lobby *models.Lobby
+	if in.Accept {
+		if _, exists := s.lobbies.get(invitation.Lobby.Id); !exists {
+			s.removeLobbyInvitation(invitation)
+			s.queueServerUpdatesAndSignal(ctx, clientId, s.createRespondLobbyInvitationReply(&Outcome{
+				Ok:           false,
+				ErrorCode:    int32(codes.NotFound),
+				ErrorMessage: "lobby does not exists",
+			}))
+			return nil
+		}
+
+		// The invitation stays open if the lobby refuses the player, so it
+		// can still be accepted once, say, the player has left their lobby.
+		lobby, outcome = s.addPlayerToLobby(ctx, invitee, invitation.Lobby.Id)
+		if !outcome.Ok {
+			s.queueServerUpdatesAndSignal(ctx, clientId, s.createRespondLobbyInvitationReply(outcome))
+			return nil
+		}
+
+		s.closeLobbyInvitation(invitation, models.InvitationStatus_ACCEPTED)
+	} else {
+		s.closeLobbyInvitation(invitation, models.InvitationStatus_DECLINED)
+	}
+
+	s.queueServerUpdatesAndSignal(ctx, clientId, s.createRespondLobbyInvitationReply(&Outcome{Ok: true}))
+	if lobby != nil {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.getLobbyEntryUpdates(invitee, lobby)...)
+	}
+
+	if inviterClientId, exists := s.playerClient.get(invitation.Inviter.Id); exists {
+		s.queueServerUpdatesAndSignal(ctx, inviterClientId, s.createLobbyInvitationResponseUpdate(invitation))
+	}
+
+	return nil
+}
@@ -1,27 +1,27 @@
 package server2
 
 import (
+	"context"
 	"strings"
 	"txtcto/models"
 )
 
-func (s *Server) searchLobby(clientId string, in *LobbySearchRequest) error {
-	_, outcome := s.validatePlayer(clientId)
+func (s *Server) searchLobby(ctx context.Context, clientId string, in *LobbySearchRequest) error {
+	_, outcome := s.validatePlayer(ctx, clientId)
 	if !outcome.Ok {
-		s.queueServerUpdatesAndSignal(clientId, s.createLobbySearchReply(outcome))
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createLobbySearchReply(outcome))
 		return nil
 	}
 
-	const listLimit = 20
 	list := []*models.Lobby{}
 	s.lobbies.forEach(func(key string, lobby *models.Lobby) bool {
 		if strings.Contains(strings.ToLower(lobby.Name), strings.ToLower(in.Name)) {
 			list = append(list, lobby)
 		}
-		return len(list) <= listLimit
+		return len(list) < s.lobbySearchLimit
 	})
 
-	s.queueServerUpdatesAndSignal(clientId,
+	s.queueServerUpdatesAndSignal(ctx, clientId,
 		s.createLobbySearchReply(&Outcome{Ok: true}),
 		s.createLobbySearchResult(list),
 	)
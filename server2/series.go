@@ -0,0 +1,164 @@
+package server2
+
+import (
+	"context"
+	"time"
+	"txtcto/models"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+)
+
+const seriesGameDelay = 3 * time.Second
+
+// validateBestOf accepts series of 3, 5 or 7 games. 0, the value of an unset
+// field, and 1 both mean a single game without a series.
+func (s *Server) validateBestOf(bestOf int32) *Outcome {
+	switch bestOf {
+	case 0, 1, 3, 5, 7:
+		return &Outcome{Ok: true}
+	}
+	return &Outcome{
+		Ok:           false,
+		ErrorCode:    int32(codes.InvalidArgument),
+		ErrorMessage: "series must be best of 3, 5 or 7, or 1 for a single game",
+	}
+}
+
+func (s *Server) setupSeries(game *models.Game, bestOf int32) *models.Series {
+	if bestOf <= 1 {
+		return nil
+	}
+
+	series := &models.Series{
+		Id:      uuid.New().String(),
+		BestOf:  bestOf,
+		Players: [2]*models.Player{game.MoverX, game.MoverO},
+		GameIds: []string{game.Id},
+	}
+
+	game.SeriesId = series.Id
+	s.series.set(series.Id, series)
+
+	return series
+}
+
+func (s *Server) getGameSeries(game *models.Game) (*models.Series, bool) {
+	if game.SeriesId == "" {
+		return nil, false
+	}
+	return s.series.get(game.SeriesId)
+}
+
+// recordSeriesResult scores an ended series game and sends the new score to
+// both players. While the series is undecided the next game is started after
+// a short delay, with the first move going to whoever moved second in the
+// game that just ended, unless one player has blocked the other by then. It
+// reports whether the series is still going on.
+func (s *Server) recordSeriesResult(ctx context.Context, series *models.Series, game *models.Game) bool {
+	if game.Winner == nil {
+		series.Draws++
+	} else if i := series.PlayerIndex(game.Winner.Id); i >= 0 {
+		series.Wins[i]++
+	}
+
+	s.queueSeriesScoreUpdates(ctx, series)
+
+	if series.Decided() {
+		return false
+	}
+
+	firstMover := game.MoverX
+	if game.FirstMover.Id == game.MoverX.Id {
+		firstMover = game.MoverO
+	}
+
+	time.AfterFunc(seriesGameDelay, func() {
+		ctx := context.Background()
+
+		// A forfeit or a deleted account ends the series while the result
+		// is shown.
+		if _, exists := s.series.get(series.Id); !exists {
+			return
+		}
+
+		for _, player := range series.Players {
+			if gameId, exists := s.playerGame.get(player.Id); exists && gameId == game.Id {
+				s.playerGame.delete(player.Id)
+			}
+		}
+		s.refreshPresence(ctx, series.Players[0].Id, series.Players[1].Id)
+
+		if s.isBlocked(series.Players[0].Id, series.Players[1].Id) {
+			s.abandonSeries(ctx, series, &Outcome{
+				Ok:           false,
+				ErrorCode:    int32(codes.FailedPrecondition),
+				ErrorMessage: "the series was ended because a player blocked the other",
+			})
+			return
+		}
+
+		next, outcome := s.setupGame(ctx, game.Creator, series.Players[0], series.Players[1])
+		if !outcome.Ok {
+			s.abandonSeries(ctx, series, outcome)
+			return
+		}
+
+		if next.MoverX.Id == firstMover.Id {
+			next.Mover = next.MoverX
+		} else {
+			next.Mover = next.MoverO
+		}
+		next.FirstMover = next.Mover
+
+		next.SeriesId = series.Id
+		series.GameIds = append(series.GameIds, next.Id)
+
+		for _, player := range series.Players {
+			if clientId, exists := s.playerClient.get(player.Id); exists {
+				s.queueServerUpdatesAndSignal(ctx, clientId,
+					s.createNavigationUpdate(NavigationPath_GAME),
+					s.createGameStartUpdate(next, player),
+					s.createNextMoverUpdate(s.areYouTheMover(next, player)),
+					s.createSeriesScoreUpdate(series, player),
+				)
+			}
+		}
+	})
+
+	return true
+}
+
+// abandonSeries ends a series whose next game could not be started and sends
+// both players the final score with the reason, then back to where they
+// were before the series.
+func (s *Server) abandonSeries(ctx context.Context, series *models.Series, outcome *Outcome) {
+	s.series.delete(series.Id)
+
+	for _, player := range series.Players {
+		clientId, exists := s.playerClient.get(player.Id)
+		if !exists {
+			continue
+		}
+		update := s.createSeriesScoreUpdate(series, player)
+		update.GetSeriesScoreUpdate().Outcome = outcome
+		s.queueServerUpdatesAndSignal(ctx, clientId, update)
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.initialServerUpdates(ctx, clientId)...)
+	}
+}
+
+func (s *Server) getSeriesUpdates(game *models.Game, you *models.Player) []*ServerUpdate {
+	series, exists := s.getGameSeries(game)
+	if !exists {
+		return []*ServerUpdate{}
+	}
+	return []*ServerUpdate{s.createSeriesScoreUpdate(series, you)}
+}
+
+func (s *Server) queueSeriesScoreUpdates(ctx context.Context, series *models.Series) {
+	for _, player := range series.Players {
+		if clientId, exists := s.playerClient.get(player.Id); exists {
+			s.queueServerUpdatesAndSignal(ctx, clientId, s.createSeriesScoreUpdate(series, player))
+		}
+	}
+}
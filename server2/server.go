@@ -3,13 +3,20 @@ package server2
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"math/rand"
+	"sync"
 	"time"
 	"txtcto/models"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
 	codes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	status "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 type Server struct {
@@ -17,41 +24,131 @@ type Server struct {
 	clients                     *safeMap[string, *models.Client]
 	players                     *safeMap[string, *models.Player]
 	clientSignal                *safeMap[string, chan struct{}]
-	clientServerUpdates         *safeMap[string, []*ServerUpdate]
+	clientServerUpdates         *safeMap[string, []queuedUpdate]
 	clientLastIndexServerUpdate *safeMap[string, int]
 	clientPlayer                *safeMap[string, string]
 	playerGame                  *safeMap[string, string]
 	games                       *safeMap[string, *models.Game]
 	playerNameId                *safeMap[string, string]
+	accountsMu                  *sync.Mutex
+	nameSignInAttempts          *safeMap[string, *models.SignInAttempts]
+	clientSignInAttempts        *safeMap[string, *models.SignInAttempts]
+	sourceSignInAttempts        *safeMap[string, *models.SignInAttempts]
+	signInMu                    *sync.Mutex
+	displayNameOwners           *safeMap[string, string]
 	playerClient                *safeMap[string, string]
 	playerLobby                 *safeMap[string, string]
 	lobbies                     *safeMap[string, *models.Lobby]
 	rematches                   *safeMap[string, *models.Rematch]
 	playerRematch               *safeMap[string, string]
 	playerSearchingLobby        *safeMap[string, bool]
+	lobbyInvitations            *safeMap[string, *models.LobbyInvitation]
+	playerLobbyInvitations      *safeMap[string, []string]
+	tournaments                 *safeMap[string, *models.Tournament]
+	tournamentsMu               *sync.Mutex
+	challengesMu                *sync.Mutex
+	lobbyInvitationsMu          *sync.Mutex
+	series                      *safeMap[string, *models.Series]
+	playerPresence              *safeMap[string, Presence]
+	challenges                  *safeMap[string, *models.Challenge]
+	clientDisconnect            *safeMap[string, chan struct{}]
+	clientLastSeen              *safeMap[string, time.Time]
+	clientConsumer              *safeMap[string, string]
+	clientBuckets               *safeMap[string, *tokenBucket]
+	consumerBuckets             *safeMap[string, *tokenBucket]
+	announcements               *safeMap[string, *models.Announcement]
+	announcementsFile           string
+	announcementsMu             *sync.Mutex
+	maintenance                 *maintenanceMode
+	pingInterval                time.Duration
+	lobbySearchLimit            int
+	bucketsMu                   *sync.Mutex
+	clientRate                  float64
+	clientBurst                 int
+	consumerRate                float64
+	consumerBurst               int
+	rematchWindow               time.Duration
+	rematchSwapSides            bool
+	displayNameDenyList         []string
+	uniqueDisplayNames          bool
+	displayNameCooldown         time.Duration
+	guestTTL                    time.Duration
+	janitorInterval             time.Duration
+	idleClientTTL               time.Duration
+	janitorStats                *janitorStats
+	metrics                     *metrics
+	logger                      *slog.Logger
+	tracer                      trace.Tracer
 
 	UnimplementedTicTacToeServer
 }
 
-func NewServer(consumers map[string]*models.Consumer) *Server {
-	return &Server{
+func NewServer(consumers map[string]*models.Consumer, opts ...Option) *Server {
+	s := &Server{
 		consumers:                   newSafeMapWith(consumers),
 		clients:                     newSafeMap[string, *models.Client](),
 		players:                     newSafeMap[string, *models.Player](),
 		clientSignal:                newSafeMap[string, chan struct{}](),
-		clientServerUpdates:         newSafeMap[string, []*ServerUpdate](),
+		clientServerUpdates:         newSafeMap[string, []queuedUpdate](),
 		clientLastIndexServerUpdate: newSafeMap[string, int](),
 		clientPlayer:                newSafeMap[string, string](),
 		playerGame:                  newSafeMap[string, string](),
 		games:                       newSafeMap[string, *models.Game](),
 		playerNameId:                newSafeMap[string, string](),
+		displayNameOwners:           newSafeMap[string, string](),
+		nameSignInAttempts:          newSafeMap[string, *models.SignInAttempts](),
+		clientSignInAttempts:        newSafeMap[string, *models.SignInAttempts](),
+		sourceSignInAttempts:        newSafeMap[string, *models.SignInAttempts](),
 		playerClient:                newSafeMap[string, string](),
 		playerLobby:                 newSafeMap[string, string](),
 		lobbies:                     newSafeMap[string, *models.Lobby](),
 		rematches:                   newSafeMap[string, *models.Rematch](),
 		playerRematch:               newSafeMap[string, string](),
 		playerSearchingLobby:        newSafeMap[string, bool](),
+		lobbyInvitations:            newSafeMap[string, *models.LobbyInvitation](),
+		playerLobbyInvitations:      newSafeMap[string, []string](),
+		tournaments:                 newSafeMap[string, *models.Tournament](),
+		series:                      newSafeMap[string, *models.Series](),
+		playerPresence:              newSafeMap[string, Presence](),
+		challenges:                  newSafeMap[string, *models.Challenge](),
+		clientDisconnect:            newSafeMap[string, chan struct{}](),
+		clientLastSeen:              newSafeMap[string, time.Time](),
+		clientConsumer:              newSafeMap[string, string](),
+		clientBuckets:               newSafeMap[string, *tokenBucket](),
+		consumerBuckets:             newSafeMap[string, *tokenBucket](),
+		announcements:               newSafeMap[string, *models.Announcement](),
+		announcementsMu:             &sync.Mutex{},
+		maintenance:                 &maintenanceMode{},
+		pingInterval:                DefaultPingInterval,
+		lobbySearchLimit:            DefaultLobbySearchLimit,
+		clientRate:                  DefaultClientRate,
+		clientBurst:                 DefaultClientBurst,
+		consumerRate:                DefaultConsumerRate,
+		consumerBurst:               DefaultConsumerBurst,
+		rematchWindow:               DefaultRematchWindow,
+		displayNameCooldown:         DefaultDisplayNameCooldown,
+		guestTTL:                    DefaultGuestTTL,
+		logger:                      slog.Default(),
+		tracer:                      otel.Tracer(tracerName),
+		accountsMu:                  &sync.Mutex{},
+		signInMu:                    &sync.Mutex{},
+		tournamentsMu:               &sync.Mutex{},
+		challengesMu:                &sync.Mutex{},
+		lobbyInvitationsMu:          &sync.Mutex{},
+		bucketsMu:                   &sync.Mutex{},
+		janitorStats:                &janitorStats{},
+		janitorInterval:             DefaultJanitorInterval,
+		idleClientTTL:               DefaultIdleClientTTL,
 	}
+	s.metrics = newMetrics(s)
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.loadAnnouncements()
+	if s.janitorInterval > 0 {
+		go s.runJanitor(context.Background())
+	}
+	return s
 }
 
 func (s *Server) extractPublicKeyWithCancel(ctx context.Context, cancelMessage string) (string, error) {
@@ -64,6 +161,10 @@ func (s *Server) extractPublicKeyWithCancel(ctx context.Context, cancelMessage s
 }
 
 func (s *Server) extractPublicKey(ctx context.Context) (string, error) {
+	if publicKey, ok := s.consumerFromCertificate(ctx); ok {
+		return publicKey, nil
+	}
+
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return "", status.Error(codes.NotFound, "metadata not ok")
@@ -76,9 +177,38 @@ func (s *Server) extractPublicKey(ctx context.Context) (string, error) {
 	if clientId == "" {
 		return "", status.Error(codes.InvalidArgument, "public key is empty")
 	}
+	if consumer, exists := s.consumers.get(clientId); exists && consumer.CertificateSubject != "" {
+		return "", status.Error(codes.Unauthenticated, "consumer must connect with its client certificate")
+	}
 	return clientId, nil
 }
 
+// consumerFromCertificate finds the consumer whose certificate subject
+// matches the verified client certificate of the connection, if any.
+func (s *Server) consumerFromCertificate(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+	subject := tlsInfo.State.VerifiedChains[0][0].Subject.String()
+
+	publicKey := ""
+	s.consumers.forEach(func(key string, consumer *models.Consumer) bool {
+		if consumer.CertificateSubject != "" && consumer.CertificateSubject == subject {
+			publicKey = key
+			return false
+		}
+		return true
+	})
+
+	return publicKey, publicKey != ""
+}
+
 func (s *Server) extractClientIdWithCancel(ctx context.Context, cancelMessage string) (string, error) {
 	select {
 	case <-ctx.Done():
@@ -104,12 +234,48 @@ func (s *Server) extractClientId(ctx context.Context) (string, error) {
 	return clientId, nil
 }
 
-func (s *Server) queueServerUpdatesAndSignal(clientId string, updates ...*ServerUpdate) {
+// queuedUpdate is an update waiting in a client's queue. The update itself may
+// be shared with other clients, so anything recorded per recipient lives on
+// the envelope instead.
+type queuedUpdate struct {
+	update        *ServerUpdate
+	spanContext   trace.SpanContext
+	correlationId string
+}
+
+// message returns the update as it goes on the wire, stamped with the
+// correlation id of the request that queued it. The shared update is cloned
+// rather than changed.
+func (q queuedUpdate) message() *ServerUpdate {
+	if q.correlationId == "" || q.update.CorrelationId == q.correlationId {
+		return q.update
+	}
+	update := proto.Clone(q.update).(*ServerUpdate)
+	update.CorrelationId = q.correlationId
+	return update
+}
+
+func (s *Server) queueServerUpdates(ctx context.Context, clientId string, updates ...*ServerUpdate) {
+	spanContext := s.traceQueuedUpdates(ctx, clientId, updates)
+
+	correlationId := ""
+	if request, exists := clientRequestFromContext(ctx); exists {
+		correlationId = request.correlationId
+		request.recordQueued(clientId, updates)
+	}
+
 	list, exists := s.clientServerUpdates.get(clientId)
 	if !exists {
-		list = []*ServerUpdate{}
+		list = []queuedUpdate{}
+	}
+	for _, update := range updates {
+		list = append(list, queuedUpdate{update: update, spanContext: spanContext, correlationId: correlationId})
 	}
-	s.clientServerUpdates.set(clientId, append(list, updates...))
+	s.clientServerUpdates.set(clientId, list)
+}
+
+func (s *Server) queueServerUpdatesAndSignal(ctx context.Context, clientId string, updates ...*ServerUpdate) {
+	s.queueServerUpdates(ctx, clientId, updates...)
 
 	if signal, exists := s.clientSignal.get(clientId); exists {
 		select {
@@ -121,6 +287,14 @@ func (s *Server) queueServerUpdatesAndSignal(clientId string, updates ...*Server
 	}
 }
 
+func (s *Server) queueServerUpdatesToLobby(ctx context.Context, lobby *models.Lobby, updates ...*ServerUpdate) {
+	for _, member := range lobby.Players {
+		if memberClientId, exists := s.playerClient.get(member.Id); exists {
+			s.queueServerUpdatesAndSignal(ctx, memberClientId, updates...)
+		}
+	}
+}
+
 func (s *Server) generateRandomString(n int) string {
 	const letterBytes = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
 	source := rand.NewSource(time.Now().UnixNano())
@@ -132,7 +306,22 @@ func (s *Server) generateRandomString(n int) string {
 	return string(b)
 }
 
-func (s *Server) validatePlayer(clientId string) (*models.Player, *Outcome) {
+// validatePlayer returns the player signed in on the client and records the
+// request as activity, which keeps a guest from expiring.
+func (s *Server) validatePlayer(ctx context.Context, clientId string) (*models.Player, *Outcome) {
+	player, outcome := s.lookupPlayer(ctx, clientId)
+	if !outcome.Ok {
+		return nil, outcome
+	}
+
+	player.LastActiveAt = time.Now()
+
+	return player, outcome
+}
+
+// lookupPlayer returns the player signed in on the client without recording
+// any activity, for updates the player did not ask for.
+func (s *Server) lookupPlayer(ctx context.Context, clientId string) (*models.Player, *Outcome) {
 	playerId, exists := s.clientPlayer.get(clientId)
 	if !exists {
 		return nil, &Outcome{
@@ -151,6 +340,11 @@ func (s *Server) validatePlayer(clientId string) (*models.Player, *Outcome) {
 		}
 	}
 
+	if outcome := s.checkPlayerBan(player); !outcome.Ok {
+		s.signOutBannedClient(ctx, clientId, player, outcome.ErrorMessage)
+		return nil, outcome
+	}
+
 	return player, &Outcome{Ok: true}
 }
 
@@ -176,6 +370,28 @@ func (s *Server) getClientIdAndPlayer(playerId string, alias string) (string, *m
 	return clientId, player, &Outcome{Ok: true}
 }
 
+func (s *Server) getPlayerLobby(playerId string) (*models.Lobby, *Outcome) {
+	lobbyId, exists := s.playerLobby.get(playerId)
+	if !exists {
+		return nil, &Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.NotFound),
+			ErrorMessage: "player does not belong to any lobby",
+		}
+	}
+
+	lobby, exists := s.lobbies.get(lobbyId)
+	if !exists {
+		return nil, &Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.NotFound),
+			ErrorMessage: "lobby does not exists",
+		}
+	}
+
+	return lobby, &Outcome{Ok: true}
+}
+
 func (s *Server) setupMover(game *models.Game, player1 *models.Player, player2 *models.Player) {
 	source := rand.NewSource(time.Now().UnixNano())
 	r := rand.New(source)
@@ -188,6 +404,7 @@ func (s *Server) setupMover(game *models.Game, player1 *models.Player, player2 *
 		game.MoverX = player2
 		game.Mover = game.MoverO
 	}
+	game.FirstMover = game.Mover
 }
 
 func (s *Server) checkDraw(game *models.Game) bool {
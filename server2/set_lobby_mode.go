@@ -0,0 +1,64 @@
+package server2
+
+import (
+	"context"
+	"txtcto/models"
+
+	"google.golang.org/grpc/codes"
+)
+
+func (s *Server) setLobbyMode(ctx context.Context, clientId string, in *SetLobbyModeRequest) error {
+	player, outcome := s.validatePlayer(ctx, clientId)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createSetLobbyModeReply(outcome))
+		return nil
+	}
+
+	lobby, outcome := s.getPlayerLobby(player.Id)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createSetLobbyModeReply(outcome))
+		return nil
+	}
+
+	if _, exists := LobbyMode_name[int32(in.Mode)]; !exists {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createSetLobbyModeReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.InvalidArgument),
+			ErrorMessage: "lobby mode is not supported",
+		}))
+		return nil
+	}
+
+	if lobby.Creator.Id != player.Id {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createSetLobbyModeReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.PermissionDenied),
+			ErrorMessage: "only the lobby host can change the lobby mode",
+		}))
+		return nil
+	}
+
+	if _, exists := s.getActiveLobbyGame(lobby); exists {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createSetLobbyModeReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.FailedPrecondition),
+			ErrorMessage: "lobby has a game in progress",
+		}))
+		return nil
+	}
+
+	lobby.Mode = models.LobbyMode(in.Mode)
+	if lobby.Mode != models.LobbyMode_WINNER_STAYS_ON {
+		lobby.ClearPlayQueue()
+	}
+
+	s.queueServerUpdatesAndSignal(ctx, clientId, s.createSetLobbyModeReply(&Outcome{Ok: true}))
+	s.queueServerUpdatesToLobby(ctx, lobby,
+		s.createMyLobbyDetails(lobby),
+		s.createPlayQueueUpdate(lobby),
+	)
+
+	s.startNextQueuedGame(ctx, lobby)
+
+	return nil
+}
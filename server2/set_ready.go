@@ -0,0 +1,53 @@
+package server2
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+)
+
+func (s *Server) setReady(ctx context.Context, clientId string, in *SetReadyRequest) error {
+	player, outcome := s.validatePlayer(ctx, clientId)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createSetReadyReply(outcome))
+		return nil
+	}
+
+	lobbyId, exists := s.playerLobby.get(player.Id)
+	if !exists {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createSetReadyReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.NotFound),
+			ErrorMessage: "player does not belong to any lobby",
+		}))
+		return nil
+	}
+
+	lobby, exists := s.lobbies.get(lobbyId)
+	if !exists {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createSetReadyReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.NotFound),
+			ErrorMessage: "lobby does not exists",
+		}))
+		return nil
+	}
+
+	if in.Ready {
+		if _, exists := s.playerGame.get(player.Id); exists {
+			s.queueServerUpdatesAndSignal(ctx, clientId, s.createSetReadyReply(&Outcome{
+				Ok:           false,
+				ErrorCode:    int32(codes.FailedPrecondition),
+				ErrorMessage: "player is currently in a game",
+			}))
+			return nil
+		}
+	}
+
+	lobby.SetReady(player.Id, in.Ready)
+
+	s.queueServerUpdatesAndSignal(ctx, clientId, s.createSetReadyReply(&Outcome{Ok: true}))
+	s.queueServerUpdatesToLobby(ctx, lobby, s.createLobbyReadyStateUpdate(lobby))
+
+	return nil
+}
@@ -1,34 +1,16 @@
 package server2
 
-import codes "google.golang.org/grpc/codes"
-
-func (s *Server) signIn(clientId string, in *SignInRequest) error {
-	playerId, exists := s.playerNameId.get(in.Name)
-	if !exists {
-		s.queueServerUpdatesAndSignal(clientId, s.createSignInReply(&Outcome{
-			Ok:           false,
-			ErrorCode:    int32(codes.NotFound),
-			ErrorMessage: "player with name not found",
-		}))
-		return nil
-	}
+import "context"
 
-	player, exists := s.players.get(playerId)
-	if !exists {
-		s.queueServerUpdatesAndSignal(clientId, s.createSignInReply(&Outcome{
-			Ok:           false,
-			ErrorCode:    int32(codes.NotFound),
-			ErrorMessage: "player not found",
-		}))
+func (s *Server) signIn(ctx context.Context, clientId string, in *SignInRequest) error {
+	player, outcome := s.authenticate(ctx, clientId, in.Name, in.Pass)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createSignInReply(outcome))
 		return nil
 	}
 
-	if player.Pass != in.Pass {
-		s.queueServerUpdatesAndSignal(clientId, s.createSignInReply(&Outcome{
-			Ok:           false,
-			ErrorCode:    int32(codes.PermissionDenied),
-			ErrorMessage: "player credentials not valid",
-		}))
+	if outcome := s.checkPlayerBan(player); !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createSignInReply(outcome))
 		return nil
 	}
 
@@ -36,7 +18,7 @@ func (s *Server) signIn(clientId string, in *SignInRequest) error {
 		if oldClientId != clientId {
 			s.clientPlayer.delete(oldClientId)
 
-			s.queueServerUpdatesAndSignal(oldClientId,
+			s.queueServerUpdatesAndSignal(ctx, oldClientId,
 				s.createNavigationUpdate(NavigationPath_WELCOME),
 				s.createPlayerDisplayNameUpdate(""),
 				s.createPlayerClientUpdate("You are using another client"),
@@ -46,13 +28,14 @@ func (s *Server) signIn(clientId string, in *SignInRequest) error {
 
 	s.clientPlayer.set(clientId, player.Id)
 	s.playerClient.set(player.Id, clientId)
+	s.refreshPresence(ctx, player.Id)
 
 	updates := []*ServerUpdate{
 		s.createSignInReply(&Outcome{Ok: true}),
 	}
-	updates = append(updates, s.initialServerUpdates(clientId)...)
+	updates = append(updates, s.initialServerUpdates(ctx, clientId)...)
 
-	s.queueServerUpdatesAndSignal(clientId, updates...)
+	s.queueServerUpdatesAndSignal(ctx, clientId, updates...)
 
 	return nil
 }
@@ -0,0 +1,141 @@
+package server2
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"time"
+	"txtcto/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	// signInFreeAttempts is how many failed sign ins a name or a client is
+	// allowed before backoff starts. A source is allowed more, since many
+	// players can share a consumer and an address.
+	signInFreeAttempts       = 3
+	signInSourceFreeAttempts = 20
+	signInBaseBackoff        = time.Second
+	signInMaxBackoff         = 15 * time.Minute
+	signInFailureWindow      = 15 * time.Minute
+	signInFailureMessage     = "player credentials not valid"
+)
+
+// authenticate checks the lockouts, verifies the credentials and records the
+// result in one step under signInMu, so parallel attempts cannot get past the
+// limit. Failures count against the name, the client and the source the sign
+// in came from, since a client id is thrown away by subscribing again. Names
+// that do not belong to any player are tracked too, so a lockout does not
+// reveal whether a name exists.
+func (s *Server) authenticate(ctx context.Context, clientId, name, pass string) (*models.Player, *Outcome) {
+	s.signInMu.Lock()
+	defer s.signInMu.Unlock()
+
+	now := time.Now()
+	source := s.signInSource(ctx, clientId)
+	for _, attempts := range []*models.SignInAttempts{
+		s.getSignInAttempts(s.nameSignInAttempts, name, now),
+		s.getSignInAttempts(s.clientSignInAttempts, clientId, now),
+		s.getSignInAttempts(s.sourceSignInAttempts, source, now),
+	} {
+		if attempts != nil && now.Before(attempts.LockedUntil) {
+			return nil, &Outcome{
+				Ok:           false,
+				ErrorCode:    int32(codes.ResourceExhausted),
+				ErrorMessage: fmt.Sprintf("too many failed sign in attempts, try again in %s", attempts.LockedUntil.Sub(now).Round(time.Second)),
+			}
+		}
+	}
+
+	player, valid := s.verifyCredentials(name, pass)
+	if !valid {
+		s.recordSignInAttemptFailure(s.nameSignInAttempts, "name", name, signInFreeAttempts, now)
+		s.recordSignInAttemptFailure(s.clientSignInAttempts, "client", clientId, signInFreeAttempts, now)
+		s.recordSignInAttemptFailure(s.sourceSignInAttempts, "source", source, signInSourceFreeAttempts, now)
+
+		return nil, &Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.PermissionDenied),
+			ErrorMessage: signInFailureMessage,
+		}
+	}
+
+	s.nameSignInAttempts.delete(name)
+	s.clientSignInAttempts.delete(clientId)
+
+	return player, &Outcome{Ok: true}
+}
+
+func (s *Server) verifyCredentials(name, pass string) (*models.Player, bool) {
+	playerId, exists := s.playerNameId.get(name)
+	if !exists {
+		return nil, false
+	}
+
+	player, exists := s.players.get(playerId)
+	if !exists || player.Pass != pass {
+		return nil, false
+	}
+
+	return player, true
+}
+
+// signInSource names where a sign in came from: the consumer the client
+// subscribed through and, when known, the address of the peer it arrived from.
+func (s *Server) signInSource(ctx context.Context, clientId string) string {
+	source, _ := s.clientConsumer.get(clientId)
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			host = p.Addr.String()
+		}
+		source += "@" + host
+	}
+	return source
+}
+
+// getSignInAttempts returns the attempts for the key, forgetting them once
+// the last failure is older than the failure window. The caller must hold
+// signInMu.
+func (s *Server) getSignInAttempts(attemptsByKey *safeMap[string, *models.SignInAttempts], key string, now time.Time) *models.SignInAttempts {
+	attempts, exists := attemptsByKey.get(key)
+	if !exists {
+		return nil
+	}
+	if now.Sub(attempts.LastFailureAt) > signInFailureWindow && !now.Before(attempts.LockedUntil) {
+		attemptsByKey.delete(key)
+		return nil
+	}
+	return attempts
+}
+
+func (s *Server) recordSignInAttemptFailure(attemptsByKey *safeMap[string, *models.SignInAttempts], kind, key string, freeAttempts int32, now time.Time) {
+	attempts := s.getSignInAttempts(attemptsByKey, key, now)
+	if attempts == nil {
+		attempts = &models.SignInAttempts{}
+		attemptsByKey.set(key, attempts)
+	}
+
+	attempts.Failures++
+	attempts.LastFailureAt = now
+
+	if attempts.Failures <= freeAttempts {
+		return
+	}
+
+	backoff := signInBaseBackoff << min(attempts.Failures-freeAttempts-1, 20)
+	if backoff > signInMaxBackoff {
+		backoff = signInMaxBackoff
+	}
+	attempts.LockedUntil = now.Add(backoff)
+
+	s.logger.Warn("audit: sign in locked out",
+		slog.String("kind", kind),
+		slog.String("key", key),
+		slog.Int("failures", int(attempts.Failures)),
+		slog.Time("until", attempts.LockedUntil),
+	)
+}
@@ -1,13 +1,14 @@
 package server2
 
 import (
+	"context"
 	codes "google.golang.org/grpc/codes"
 )
 
-func (s *Server) signOut(clientId string) error {
+func (s *Server) signOut(ctx context.Context, clientId string) error {
 	playerId, exists := s.clientPlayer.get(clientId)
 	if !exists {
-		s.queueServerUpdatesAndSignal(clientId, s.createSignOutReply(&Outcome{
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createSignOutReply(&Outcome{
 			Ok:           false,
 			ErrorCode:    int32(codes.NotFound),
 			ErrorMessage: "player not found",
@@ -15,8 +16,9 @@ func (s *Server) signOut(clientId string) error {
 		return nil
 	}
 
-	if _, exists := s.players.get(playerId); !exists {
-		s.queueServerUpdatesAndSignal(clientId, s.createSignOutReply(&Outcome{
+	player, exists := s.players.get(playerId)
+	if !exists {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createSignOutReply(&Outcome{
 			Ok:           false,
 			ErrorCode:    int32(codes.NotFound),
 			ErrorMessage: "player details not found",
@@ -25,7 +27,7 @@ func (s *Server) signOut(clientId string) error {
 	}
 
 	if _, exists := s.playerGame.get(playerId); exists {
-		s.queueServerUpdatesAndSignal(clientId, s.createSignOutReply(&Outcome{
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createSignOutReply(&Outcome{
 			Ok:           false,
 			ErrorCode:    int32(codes.NotFound),
 			ErrorMessage: "player is currently in a game",
@@ -33,10 +35,15 @@ func (s *Server) signOut(clientId string) error {
 		return nil
 	}
 
-	s.clientPlayer.delete(clientId)
-	s.playerClient.delete(playerId)
+	if player.Guest {
+		s.purgePlayer(ctx, player, clientId, "")
+	} else {
+		s.clientPlayer.delete(clientId)
+		s.playerClient.delete(playerId)
+		s.refreshPresence(ctx, playerId)
+	}
 
-	s.queueServerUpdatesAndSignal(clientId,
+	s.queueServerUpdatesAndSignal(ctx, clientId,
 		s.createSignOutReply(&Outcome{Ok: true}),
 		s.createPlayerDisplayNameUpdate(""),
 		s.createNavigationUpdate(NavigationPath_WELCOME),
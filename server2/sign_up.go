@@ -1,16 +1,21 @@
 package server2
 
 import (
-	"fmt"
+	"context"
 	"txtcto/models"
 
 	"github.com/google/uuid"
 	codes "google.golang.org/grpc/codes"
 )
 
-func (s *Server) signUp(clientId string, in *SignUpRequest) error {
+const defaultRating = 1200
+
+func (s *Server) signUp(ctx context.Context, clientId string, in *SignUpRequest) error {
+	s.accountsMu.Lock()
+	defer s.accountsMu.Unlock()
+
 	if _, exists := s.playerNameId.get(in.Name); exists {
-		s.queueServerUpdatesAndSignal(clientId, s.createSignUpReply(&Outcome{
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createSignUpReply(&Outcome{
 			Ok:           false,
 			ErrorCode:    int32(codes.AlreadyExists),
 			ErrorMessage: "player with name already exists",
@@ -18,10 +23,24 @@ func (s *Server) signUp(clientId string, in *SignUpRequest) error {
 		return nil
 	}
 
+	if guest, exists := s.getClientGuest(clientId); exists {
+		guest.Name = in.Name
+		guest.Pass = in.Pass
+		guest.Guest = false
+		guest.Rating = defaultRating
+		s.playerNameId.set(guest.Name, guest.Id)
+
+		s.queueServerUpdatesAndSignal(ctx, clientId,
+			s.createSignUpReply(&Outcome{Ok: true}),
+			s.createPlayerDisplayNameUpdate(guest.DisplayName),
+		)
+		return nil
+	}
+
 	id := uuid.New().String()
 
 	if _, exists := s.players.get(id); exists {
-		s.queueServerUpdatesAndSignal(clientId, s.createSignUpReply(&Outcome{
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createSignUpReply(&Outcome{
 			Ok:           false,
 			ErrorCode:    int32(codes.AlreadyExists),
 			ErrorMessage: "unable to generate player",
@@ -30,19 +49,27 @@ func (s *Server) signUp(clientId string, in *SignUpRequest) error {
 	}
 
 	player := &models.Player{
-		Id:          uuid.New().String(),
-		Name:        in.Name,
-		Pass:        in.Pass,
-		DisplayName: fmt.Sprintf("user%s", s.generateRandomString(12)),
+		Id:     uuid.New().String(),
+		Name:   in.Name,
+		Pass:   in.Pass,
+		Rating: defaultRating,
 	}
 
+	displayName, outcome := s.claimGeneratedDisplayName(player.Id, "user", 12)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createSignUpReply(outcome))
+		return nil
+	}
+	player.DisplayName = displayName
+
 	s.players.set(player.Id, player)
 	s.playerNameId.set(player.Name, player.Id)
 	s.playerClient.set(player.Id, clientId)
 
 	s.clientPlayer.set(clientId, player.Id)
+	s.refreshPresence(ctx, player.Id)
 
-	s.queueServerUpdatesAndSignal(clientId,
+	s.queueServerUpdatesAndSignal(ctx, clientId,
 		s.createSignUpReply(&Outcome{Ok: true}),
 		s.createNavigationUpdate(NavigationPath_HOME),
 		s.createPlayerDisplayNameUpdate(player.DisplayName),
@@ -50,3 +77,20 @@ func (s *Server) signUp(clientId string, in *SignUpRequest) error {
 
 	return nil
 }
+
+// getClientGuest returns the guest the client is playing as, if any. Signing
+// up as a guest turns the guest into a full account in place, so lobbies and
+// games the guest is part of carry over.
+func (s *Server) getClientGuest(clientId string) (*models.Player, bool) {
+	playerId, exists := s.clientPlayer.get(clientId)
+	if !exists {
+		return nil, false
+	}
+
+	player, exists := s.players.get(playerId)
+	if !exists || !player.Guest {
+		return nil, false
+	}
+
+	return player, true
+}
@@ -1,16 +1,20 @@
 package server2
 
 import (
+	"context"
 	"time"
 	"txtcto/models"
 
 	"github.com/google/uuid"
+	otelcodes "go.opentelemetry.io/otel/codes"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 )
 
 func (s *Server) Subscribe(emp *Empty, stream TicTacToe_SubscribeServer) error {
-	publicKey, err := s.extractPublicKeyWithCancel(stream.Context(), "subscribe was cancelled")
+	ctx := stream.Context()
+
+	publicKey, err := s.extractPublicKeyWithCancel(ctx, "subscribe was cancelled")
 	if err != nil {
 		return err
 	}
@@ -19,7 +23,7 @@ func (s *Server) Subscribe(emp *Empty, stream TicTacToe_SubscribeServer) error {
 		return status.Error(codes.PermissionDenied, "rejected")
 	}
 
-	clientId, err := s.extractClientId(stream.Context())
+	clientId, err := s.extractClientId(ctx)
 	if err != nil {
 		clientId = uuid.New().String()
 		s.clients.set(clientId, &models.Client{Id: clientId})
@@ -35,31 +39,43 @@ func (s *Server) Subscribe(emp *Empty, stream TicTacToe_SubscribeServer) error {
 	}
 
 	if _, exists := s.clientServerUpdates.get(clientId); !exists {
-		s.clientServerUpdates.set(clientId, []*ServerUpdate{})
+		s.clientServerUpdates.set(clientId, []queuedUpdate{})
 	}
 
 	if _, exists := s.clientSignal.get(clientId); !exists {
 		s.clientSignal.set(clientId, make(chan struct{}, 1))
 	}
 
-	s.sendInitialServerUpdates(clientId, stream)
+	disconnect := make(chan struct{}, 1)
+	s.clientDisconnect.set(clientId, disconnect)
+	s.clientConsumer.set(clientId, publicKey)
+	s.touchClient(clientId)
+	s.enforcePlayerBan(ctx, clientId)
+
+	s.sendInitialServerUpdates(ctx, clientId, stream)
+
+	if playerId, exists := s.clientPlayer.get(clientId); exists {
+		s.refreshPresence(ctx, playerId)
+	}
 
 	signal, _ := s.clientSignal.get(clientId)
 
-	defer s.cleanupClientResources(clientId)
+	defer s.cleanupClientResources(ctx, clientId)
 
-	pingInterval := 100 * time.Millisecond
-	pingTicker := time.NewTicker(pingInterval)
+	pingTicker := time.NewTicker(s.pingInterval)
 	defer pingTicker.Stop()
 
 	for {
 		select {
 		case <-stream.Context().Done():
 			return status.Error(codes.Canceled, "subscribe was done")
+		case <-disconnect:
+			return status.Error(codes.ResourceExhausted, "too many requests")
 		case <-pingTicker.C:
 			if err := stream.Send(s.createPing()); err != nil {
 				return err
 			}
+			s.touchClient(clientId)
 		case <-signal:
 			if err := s.sendServerUpdates(stream, clientId); err != nil {
 				return err
@@ -76,23 +92,30 @@ func (s *Server) sendServerUpdates(stream TicTacToe_SubscribeServer, clientId st
 	}
 
 	if _, exists := s.clientServerUpdates.get(clientId); !exists {
-		s.clientServerUpdates.set(clientId, []*ServerUpdate{})
+		s.clientServerUpdates.set(clientId, []queuedUpdate{})
 	}
 	serverUpdates, _ := s.clientServerUpdates.get(clientId)
 
-	var serverUpdatesToSend []*ServerUpdate
+	var serverUpdatesToSend []queuedUpdate
 
 	if startIndex < len(serverUpdates) {
 		serverUpdatesToSend = serverUpdates[startIndex:]
 	} else {
-		serverUpdatesToSend = []*ServerUpdate{}
+		serverUpdatesToSend = []queuedUpdate{}
 	}
 
 	sentCount := 0
 	var err error
 
-	for _, serverUpdate := range serverUpdatesToSend {
-		if e := stream.Send(serverUpdate); e != nil {
+	for _, queued := range serverUpdatesToSend {
+		span := s.startSendSpan(clientId, queued)
+		e := stream.Send(queued.message())
+		if e != nil {
+			span.RecordError(e)
+			span.SetStatus(otelcodes.Error, e.Error())
+		}
+		span.End()
+		if e != nil {
 			err = e
 			break
 		}
@@ -111,13 +134,10 @@ func (s *Server) sendServerUpdates(stream TicTacToe_SubscribeServer, clientId st
 	return err
 }
 
-func (s *Server) sendInitialServerUpdates(clientId string, stream TicTacToe_SubscribeServer) {
-	initialUpdates := s.initialServerUpdates(clientId)
+func (s *Server) sendInitialServerUpdates(ctx context.Context, clientId string, stream TicTacToe_SubscribeServer) {
+	initialUpdates := s.initialServerUpdates(ctx, clientId)
 
-	serverUpdates, exists := s.clientServerUpdates.get(clientId)
-	if !exists {
-		serverUpdates = []*ServerUpdate{}
-	}
+	serverUpdates := []*ServerUpdate{}
 
 	if playerId, exists := s.clientPlayer.get(clientId); exists {
 		if player, exists := s.players.get(playerId); exists {
@@ -126,18 +146,22 @@ func (s *Server) sendInitialServerUpdates(clientId string, stream TicTacToe_Subs
 	}
 
 	serverUpdates = append(serverUpdates, initialUpdates...)
-	s.clientServerUpdates.set(clientId, serverUpdates)
+	serverUpdates = append(serverUpdates, s.getAnnouncementInitialUpdates()...)
+	s.queueServerUpdates(ctx, clientId, serverUpdates...)
 
 	s.sendServerUpdates(stream, clientId)
 }
 
-func (s *Server) initialServerUpdates(clientId string) []*ServerUpdate {
-	player, outcome := s.validatePlayer(clientId)
+func (s *Server) initialServerUpdates(ctx context.Context, clientId string) []*ServerUpdate {
+	player, outcome := s.lookupPlayer(ctx, clientId)
 	if !outcome.Ok {
 		return []*ServerUpdate{s.createNavigationUpdate(NavigationPath_WELCOME)}
 	}
 
 	updates := []*ServerUpdate{s.createPlayerDisplayNameUpdate(player.DisplayName)}
+	updates = append(updates, s.createFriendListUpdate(player), s.createBlockListUpdate(player))
+	updates = append(updates, s.getLobbyInvitationInitialUpdates(player.Id)...)
+	updates = append(updates, s.getChallengeInitialUpdates(player.Id)...)
 
 	rematchUpdates := s.getRematchInitialUpdates(player.Id)
 	if len(rematchUpdates) > 0 {
@@ -157,8 +181,14 @@ func (s *Server) initialServerUpdates(clientId string) []*ServerUpdate {
 	return append(updates, s.createNavigationUpdate(NavigationPath_HOME))
 }
 
-func (s *Server) cleanupClientResources(clientId string) {
+func (s *Server) cleanupClientResources(ctx context.Context, clientId string) {
 	s.clientSignal.delete(clientId)
+	s.clientDisconnect.delete(clientId)
+	s.touchClient(clientId)
+
+	if playerId, exists := s.clientPlayer.get(clientId); exists {
+		s.refreshPresence(ctx, playerId)
+	}
 }
 
 func (s *Server) getLobbyInitialUpdates(playerId string) []*ServerUpdate {
@@ -168,6 +198,8 @@ func (s *Server) getLobbyInitialUpdates(playerId string) []*ServerUpdate {
 			return []*ServerUpdate{
 				s.createNavigationUpdate(NavigationPath_MY_LOBBY),
 				s.createMyLobbyDetails(lobby),
+				s.createLobbyReadyStateUpdate(lobby),
+				s.createPlayQueueUpdate(lobby),
 			}
 		} else {
 			s.playerLobby.delete(playerId)
@@ -194,16 +226,19 @@ func (s *Server) getGameInitialUpdates(you *models.Player) []*ServerUpdate {
 		s.createNextMoverUpdate(s.areYouTheMover(game, you)),
 	}
 	updates = append(updates, s.createMoveUpdates(game)...)
+	updates = append(updates, s.getSeriesUpdates(game, you)...)
 
 	switch game.Result {
 	case models.GameResult_DRAW:
 		updates = append(updates, s.createDrawUpdate())
 	case models.GameResult_WIN:
-		updates = append(updates, s.createWinnerUpdate(s.areYouTheMover(game, you), Technicality_NO_PROBLEM))
+		updates = append(updates, s.createWinnerUpdate(game.Winner.Id == you.Id, Technicality_NO_PROBLEM))
 	case models.GameResult_WIN_BY_FORFEIT:
-		updates = append(updates, s.createWinnerUpdate(s.areYouTheMover(game, you), Technicality_BY_FORFEIT))
+		updates = append(updates, s.createWinnerUpdate(game.Winner.Id == you.Id, Technicality_BY_FORFEIT))
 	}
 
+	updates = append(updates, s.getRematchCountdownUpdates(you.Id)...)
+
 	return updates
 }
 
@@ -235,5 +270,6 @@ func (s *Server) getRematchInitialUpdates(playerId string) []*ServerUpdate {
 		return []*ServerUpdate{}
 	}
 
-	return []*ServerUpdate{s.createNavigationUpdate(NavigationPath_REMATCH)}
+	updates := []*ServerUpdate{s.createNavigationUpdate(NavigationPath_REMATCH)}
+	return append(updates, s.getRematchCountdownUpdates(playerId)...)
 }
@@ -1,6 +1,7 @@
 package server2
 
 import (
+	"fmt"
 	"io"
 	"time"
 	"txtcto/models"
@@ -11,7 +12,9 @@ import (
 )
 
 func (s *Server) SubscribeBiDir(stream TicTacToe_SubscribeBiDirServer) error {
-	publicKey, err := s.extractPublicKeyWithCancel(stream.Context(), "subscribe was cancelled")
+	ctx := stream.Context()
+
+	publicKey, err := s.extractPublicKeyWithCancel(ctx, "subscribe was cancelled")
 	if err != nil {
 		return err
 	}
@@ -20,7 +23,7 @@ func (s *Server) SubscribeBiDir(stream TicTacToe_SubscribeBiDirServer) error {
 		return status.Error(codes.PermissionDenied, "rejected")
 	}
 
-	clientId, err := s.extractClientId(stream.Context())
+	clientId, err := s.extractClientId(ctx)
 	if err != nil {
 		clientId = uuid.New().String()
 		s.clients.set(clientId, &models.Client{Id: clientId})
@@ -36,21 +39,33 @@ func (s *Server) SubscribeBiDir(stream TicTacToe_SubscribeBiDirServer) error {
 	}
 
 	if _, exists := s.clientServerUpdates.get(clientId); !exists {
-		s.clientServerUpdates.set(clientId, []*ServerUpdate{})
+		s.clientServerUpdates.set(clientId, []queuedUpdate{})
 	}
 
 	if _, exists := s.clientSignal.get(clientId); !exists {
 		s.clientSignal.set(clientId, make(chan struct{}, 1))
 	}
 
-	s.sendInitialServerUpdates(clientId, stream)
+	disconnect := make(chan struct{}, 1)
+	s.clientDisconnect.set(clientId, disconnect)
+	s.clientConsumer.set(clientId, publicKey)
+	s.touchClient(clientId)
+	s.enforcePlayerBan(ctx, clientId)
+
+	s.sendInitialServerUpdates(ctx, clientId, stream)
+
+	if playerId, exists := s.clientPlayer.get(clientId); exists {
+		s.refreshPresence(ctx, playerId)
+	}
 
 	signal, _ := s.clientSignal.get(clientId)
 
-	defer s.cleanupClientResources(clientId)
+	defer s.cleanupClientResources(ctx, clientId)
+
+	streamCorrelationId := correlationIdFromContext(stream.Context())
 
 	go func() {
-		for {
+		for sequence := 1; ; sequence++ {
 			clientUpdate, err := stream.Recv()
 			if err == io.EOF {
 				return
@@ -58,22 +73,28 @@ func (s *Server) SubscribeBiDir(stream TicTacToe_SubscribeBiDirServer) error {
 			if err != nil {
 				return
 			}
-			s.Notify(stream.Context(), clientUpdate)
+			if outcome := s.allowClientUpdate(clientId, clientUpdate); !outcome.Ok {
+				s.queueServerUpdatesAndSignal(ctx, clientId, s.createRateLimitUpdate(outcome))
+				continue
+			}
+			s.handleClientUpdate(stream.Context(), clientId, publicKey, fmt.Sprintf("%s.%d", streamCorrelationId, sequence), clientUpdate)
 		}
 	}()
 
-	pingInterval := 100 * time.Millisecond
-	pingTicker := time.NewTicker(pingInterval)
+	pingTicker := time.NewTicker(s.pingInterval)
 	defer pingTicker.Stop()
 
 	for {
 		select {
 		case <-stream.Context().Done():
 			return status.Error(codes.Canceled, "subscribe was done")
+		case <-disconnect:
+			return status.Error(codes.ResourceExhausted, "too many requests")
 		case <-pingTicker.C:
 			if err := stream.Send(s.createPing()); err != nil {
 				return err
 			}
+			s.touchClient(clientId)
 		case <-signal:
 			if err := s.sendServerUpdates(stream, clientId); err != nil {
 				return err
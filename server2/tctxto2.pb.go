@@ -167,6 +167,410 @@ func (Technicality) EnumDescriptor() ([]byte, []int) {
 	return file_server2_tctxto2_proto_rawDescGZIP(), []int{2}
 }
 
+type InvitationStatus int32
+
+const (
+	InvitationStatus_PENDING   InvitationStatus = 0
+	InvitationStatus_ACCEPTED  InvitationStatus = 1
+	InvitationStatus_DECLINED  InvitationStatus = 2
+	InvitationStatus_EXPIRED   InvitationStatus = 3
+	InvitationStatus_WITHDRAWN InvitationStatus = 4
+)
+
+// Enum value maps for InvitationStatus.
+var (
+	InvitationStatus_name = map[int32]string{
+		0: "PENDING",
+		1: "ACCEPTED",
+		2: "DECLINED",
+		3: "EXPIRED",
+		4: "WITHDRAWN",
+	}
+	InvitationStatus_value = map[string]int32{
+		"PENDING":   0,
+		"ACCEPTED":  1,
+		"DECLINED":  2,
+		"EXPIRED":   3,
+		"WITHDRAWN": 4,
+	}
+)
+
+func (x InvitationStatus) Enum() *InvitationStatus {
+	p := new(InvitationStatus)
+	*p = x
+	return p
+}
+
+func (x InvitationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InvitationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_server2_tctxto2_proto_enumTypes[3].Descriptor()
+}
+
+func (InvitationStatus) Type() protoreflect.EnumType {
+	return &file_server2_tctxto2_proto_enumTypes[3]
+}
+
+func (x InvitationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InvitationStatus.Descriptor instead.
+func (InvitationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{3}
+}
+
+type LobbyMode int32
+
+const (
+	LobbyMode_STANDARD        LobbyMode = 0
+	LobbyMode_WINNER_STAYS_ON LobbyMode = 1
+)
+
+// Enum value maps for LobbyMode.
+var (
+	LobbyMode_name = map[int32]string{
+		0: "STANDARD",
+		1: "WINNER_STAYS_ON",
+	}
+	LobbyMode_value = map[string]int32{
+		"STANDARD":        0,
+		"WINNER_STAYS_ON": 1,
+	}
+)
+
+func (x LobbyMode) Enum() *LobbyMode {
+	p := new(LobbyMode)
+	*p = x
+	return p
+}
+
+func (x LobbyMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LobbyMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_server2_tctxto2_proto_enumTypes[4].Descriptor()
+}
+
+func (LobbyMode) Type() protoreflect.EnumType {
+	return &file_server2_tctxto2_proto_enumTypes[4]
+}
+
+func (x LobbyMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LobbyMode.Descriptor instead.
+func (LobbyMode) EnumDescriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{4}
+}
+
+type TournamentFormat int32
+
+const (
+	TournamentFormat_SINGLE_ELIMINATION TournamentFormat = 0
+	TournamentFormat_DOUBLE_ELIMINATION TournamentFormat = 1
+	TournamentFormat_ROUND_ROBIN        TournamentFormat = 2
+)
+
+// Enum value maps for TournamentFormat.
+var (
+	TournamentFormat_name = map[int32]string{
+		0: "SINGLE_ELIMINATION",
+		1: "DOUBLE_ELIMINATION",
+		2: "ROUND_ROBIN",
+	}
+	TournamentFormat_value = map[string]int32{
+		"SINGLE_ELIMINATION": 0,
+		"DOUBLE_ELIMINATION": 1,
+		"ROUND_ROBIN":        2,
+	}
+)
+
+func (x TournamentFormat) Enum() *TournamentFormat {
+	p := new(TournamentFormat)
+	*p = x
+	return p
+}
+
+func (x TournamentFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TournamentFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_server2_tctxto2_proto_enumTypes[5].Descriptor()
+}
+
+func (TournamentFormat) Type() protoreflect.EnumType {
+	return &file_server2_tctxto2_proto_enumTypes[5]
+}
+
+func (x TournamentFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TournamentFormat.Descriptor instead.
+func (TournamentFormat) EnumDescriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{5}
+}
+
+type TournamentStatus int32
+
+const (
+	TournamentStatus_REGISTRATION TournamentStatus = 0
+	TournamentStatus_IN_PROGRESS  TournamentStatus = 1
+	TournamentStatus_FINISHED     TournamentStatus = 2
+	TournamentStatus_CANCELLED    TournamentStatus = 3
+)
+
+// Enum value maps for TournamentStatus.
+var (
+	TournamentStatus_name = map[int32]string{
+		0: "REGISTRATION",
+		1: "IN_PROGRESS",
+		2: "FINISHED",
+		3: "CANCELLED",
+	}
+	TournamentStatus_value = map[string]int32{
+		"REGISTRATION": 0,
+		"IN_PROGRESS":  1,
+		"FINISHED":     2,
+		"CANCELLED":    3,
+	}
+)
+
+func (x TournamentStatus) Enum() *TournamentStatus {
+	p := new(TournamentStatus)
+	*p = x
+	return p
+}
+
+func (x TournamentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TournamentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_server2_tctxto2_proto_enumTypes[6].Descriptor()
+}
+
+func (TournamentStatus) Type() protoreflect.EnumType {
+	return &file_server2_tctxto2_proto_enumTypes[6]
+}
+
+func (x TournamentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TournamentStatus.Descriptor instead.
+func (TournamentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{6}
+}
+
+type Presence int32
+
+const (
+	Presence_OFFLINE  Presence = 0
+	Presence_ONLINE   Presence = 1
+	Presence_IN_LOBBY Presence = 2
+	Presence_IN_GAME  Presence = 3
+)
+
+// Enum value maps for Presence.
+var (
+	Presence_name = map[int32]string{
+		0: "OFFLINE",
+		1: "ONLINE",
+		2: "IN_LOBBY",
+		3: "IN_GAME",
+	}
+	Presence_value = map[string]int32{
+		"OFFLINE":  0,
+		"ONLINE":   1,
+		"IN_LOBBY": 2,
+		"IN_GAME":  3,
+	}
+)
+
+func (x Presence) Enum() *Presence {
+	p := new(Presence)
+	*p = x
+	return p
+}
+
+func (x Presence) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Presence) Descriptor() protoreflect.EnumDescriptor {
+	return file_server2_tctxto2_proto_enumTypes[7].Descriptor()
+}
+
+func (Presence) Type() protoreflect.EnumType {
+	return &file_server2_tctxto2_proto_enumTypes[7]
+}
+
+func (x Presence) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Presence.Descriptor instead.
+func (Presence) EnumDescriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{7}
+}
+
+type AdminGameResult int32
+
+const (
+	AdminGameResult_GAME_INITIAL        AdminGameResult = 0
+	AdminGameResult_GAME_ONGOING        AdminGameResult = 1
+	AdminGameResult_GAME_WIN            AdminGameResult = 2
+	AdminGameResult_GAME_DRAW           AdminGameResult = 3
+	AdminGameResult_GAME_WIN_BY_FORFEIT AdminGameResult = 4
+)
+
+// Enum value maps for AdminGameResult.
+var (
+	AdminGameResult_name = map[int32]string{
+		0: "GAME_INITIAL",
+		1: "GAME_ONGOING",
+		2: "GAME_WIN",
+		3: "GAME_DRAW",
+		4: "GAME_WIN_BY_FORFEIT",
+	}
+	AdminGameResult_value = map[string]int32{
+		"GAME_INITIAL":        0,
+		"GAME_ONGOING":        1,
+		"GAME_WIN":            2,
+		"GAME_DRAW":           3,
+		"GAME_WIN_BY_FORFEIT": 4,
+	}
+)
+
+func (x AdminGameResult) Enum() *AdminGameResult {
+	p := new(AdminGameResult)
+	*p = x
+	return p
+}
+
+func (x AdminGameResult) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AdminGameResult) Descriptor() protoreflect.EnumDescriptor {
+	return file_server2_tctxto2_proto_enumTypes[8].Descriptor()
+}
+
+func (AdminGameResult) Type() protoreflect.EnumType {
+	return &file_server2_tctxto2_proto_enumTypes[8]
+}
+
+func (x AdminGameResult) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AdminGameResult.Descriptor instead.
+func (AdminGameResult) EnumDescriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{8}
+}
+
+type ForcedGameResult int32
+
+const (
+	ForcedGameResult_FORCED_DRAW ForcedGameResult = 0
+	ForcedGameResult_FORCED_WIN  ForcedGameResult = 1
+)
+
+// Enum value maps for ForcedGameResult.
+var (
+	ForcedGameResult_name = map[int32]string{
+		0: "FORCED_DRAW",
+		1: "FORCED_WIN",
+	}
+	ForcedGameResult_value = map[string]int32{
+		"FORCED_DRAW": 0,
+		"FORCED_WIN":  1,
+	}
+)
+
+func (x ForcedGameResult) Enum() *ForcedGameResult {
+	p := new(ForcedGameResult)
+	*p = x
+	return p
+}
+
+func (x ForcedGameResult) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ForcedGameResult) Descriptor() protoreflect.EnumDescriptor {
+	return file_server2_tctxto2_proto_enumTypes[9].Descriptor()
+}
+
+func (ForcedGameResult) Type() protoreflect.EnumType {
+	return &file_server2_tctxto2_proto_enumTypes[9]
+}
+
+func (x ForcedGameResult) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ForcedGameResult.Descriptor instead.
+func (ForcedGameResult) EnumDescriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{9}
+}
+
+type AnnouncementSeverity int32
+
+const (
+	AnnouncementSeverity_INFO     AnnouncementSeverity = 0
+	AnnouncementSeverity_WARNING  AnnouncementSeverity = 1
+	AnnouncementSeverity_CRITICAL AnnouncementSeverity = 2
+)
+
+// Enum value maps for AnnouncementSeverity.
+var (
+	AnnouncementSeverity_name = map[int32]string{
+		0: "INFO",
+		1: "WARNING",
+		2: "CRITICAL",
+	}
+	AnnouncementSeverity_value = map[string]int32{
+		"INFO":     0,
+		"WARNING":  1,
+		"CRITICAL": 2,
+	}
+)
+
+func (x AnnouncementSeverity) Enum() *AnnouncementSeverity {
+	p := new(AnnouncementSeverity)
+	*p = x
+	return p
+}
+
+func (x AnnouncementSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnnouncementSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_server2_tctxto2_proto_enumTypes[10].Descriptor()
+}
+
+func (AnnouncementSeverity) Type() protoreflect.EnumType {
+	return &file_server2_tctxto2_proto_enumTypes[10]
+}
+
+func (x AnnouncementSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnnouncementSeverity.Descriptor instead.
+func (AnnouncementSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{10}
+}
+
 type Empty struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -222,6 +626,29 @@ type ClientUpdate struct {
 	//	*ClientUpdate_RematchRequest
 	//	*ClientUpdate_ChangePlayerDisplayNameRequest
 	//	*ClientUpdate_LobbySearchRequest
+	//	*ClientUpdate_InviteToLobbyRequest
+	//	*ClientUpdate_RespondLobbyInvitationRequest
+	//	*ClientUpdate_SetReadyRequest
+	//	*ClientUpdate_SetLobbyModeRequest
+	//	*ClientUpdate_JoinPlayQueueRequest
+	//	*ClientUpdate_LeavePlayQueueRequest
+	//	*ClientUpdate_CreateTournamentRequest
+	//	*ClientUpdate_JoinTournamentRequest
+	//	*ClientUpdate_LeaveTournamentRequest
+	//	*ClientUpdate_WatchTournamentRequest
+	//	*ClientUpdate_GetTournamentRequest
+	//	*ClientUpdate_AddFriendRequest
+	//	*ClientUpdate_RemoveFriendRequest
+	//	*ClientUpdate_RespondFriendRequest
+	//	*ClientUpdate_ChallengePlayerRequest
+	//	*ClientUpdate_RespondChallengeRequest
+	//	*ClientUpdate_CancelChallengeRequest
+	//	*ClientUpdate_BlockPlayerRequest
+	//	*ClientUpdate_UnblockPlayerRequest
+	//	*ClientUpdate_ChangePasswordRequest
+	//	*ClientUpdate_ChangeUsernameRequest
+	//	*ClientUpdate_DeleteAccountRequest
+	//	*ClientUpdate_PlayAsGuestRequest
 	Type isClientUpdate_Type `protobuf_oneof:"type"`
 }
 
@@ -341,55 +768,308 @@ func (x *ClientUpdate) GetLobbySearchRequest() *LobbySearchRequest {
 	return nil
 }
 
-type isClientUpdate_Type interface {
-	isClientUpdate_Type()
+func (x *ClientUpdate) GetInviteToLobbyRequest() *InviteToLobbyRequest {
+	if x, ok := x.GetType().(*ClientUpdate_InviteToLobbyRequest); ok {
+		return x.InviteToLobbyRequest
+	}
+	return nil
 }
 
-type ClientUpdate_SignUpRequest struct {
-	SignUpRequest *SignUpRequest `protobuf:"bytes,1,opt,name=sign_up_request,json=signUpRequest,proto3,oneof"`
+func (x *ClientUpdate) GetRespondLobbyInvitationRequest() *RespondLobbyInvitationRequest {
+	if x, ok := x.GetType().(*ClientUpdate_RespondLobbyInvitationRequest); ok {
+		return x.RespondLobbyInvitationRequest
+	}
+	return nil
 }
 
-type ClientUpdate_SignInRequest struct {
-	SignInRequest *SignInRequest `protobuf:"bytes,2,opt,name=sign_in_request,json=signInRequest,proto3,oneof"`
+func (x *ClientUpdate) GetSetReadyRequest() *SetReadyRequest {
+	if x, ok := x.GetType().(*ClientUpdate_SetReadyRequest); ok {
+		return x.SetReadyRequest
+	}
+	return nil
 }
 
-type ClientUpdate_SignOutRequest struct {
-	SignOutRequest *SignOutRequest `protobuf:"bytes,3,opt,name=sign_out_request,json=signOutRequest,proto3,oneof"`
+func (x *ClientUpdate) GetSetLobbyModeRequest() *SetLobbyModeRequest {
+	if x, ok := x.GetType().(*ClientUpdate_SetLobbyModeRequest); ok {
+		return x.SetLobbyModeRequest
+	}
+	return nil
 }
 
-type ClientUpdate_CreateLobbyRequest struct {
-	CreateLobbyRequest *CreateLobbyRequest `protobuf:"bytes,4,opt,name=create_lobby_request,json=createLobbyRequest,proto3,oneof"`
+func (x *ClientUpdate) GetJoinPlayQueueRequest() *JoinPlayQueueRequest {
+	if x, ok := x.GetType().(*ClientUpdate_JoinPlayQueueRequest); ok {
+		return x.JoinPlayQueueRequest
+	}
+	return nil
 }
 
-type ClientUpdate_JoinLobbyRequest struct {
-	JoinLobbyRequest *JoinLobbyRequest `protobuf:"bytes,5,opt,name=join_lobby_request,json=joinLobbyRequest,proto3,oneof"`
+func (x *ClientUpdate) GetLeavePlayQueueRequest() *LeavePlayQueueRequest {
+	if x, ok := x.GetType().(*ClientUpdate_LeavePlayQueueRequest); ok {
+		return x.LeavePlayQueueRequest
+	}
+	return nil
 }
 
-type ClientUpdate_LeaveMyLobbyRequest struct {
-	LeaveMyLobbyRequest *LeaveMyLobbyRequest `protobuf:"bytes,6,opt,name=leave_my_lobby_request,json=leaveMyLobbyRequest,proto3,oneof"`
+func (x *ClientUpdate) GetCreateTournamentRequest() *CreateTournamentRequest {
+	if x, ok := x.GetType().(*ClientUpdate_CreateTournamentRequest); ok {
+		return x.CreateTournamentRequest
+	}
+	return nil
 }
 
-type ClientUpdate_CreateGameRequest struct {
-	CreateGameRequest *CreateGameRequest `protobuf:"bytes,7,opt,name=create_game_request,json=createGameRequest,proto3,oneof"`
+func (x *ClientUpdate) GetJoinTournamentRequest() *JoinTournamentRequest {
+	if x, ok := x.GetType().(*ClientUpdate_JoinTournamentRequest); ok {
+		return x.JoinTournamentRequest
+	}
+	return nil
 }
 
-type ClientUpdate_MakeMoveRequest struct {
-	MakeMoveRequest *MakeMoveRequest `protobuf:"bytes,8,opt,name=make_move_request,json=makeMoveRequest,proto3,oneof"`
+func (x *ClientUpdate) GetLeaveTournamentRequest() *LeaveTournamentRequest {
+	if x, ok := x.GetType().(*ClientUpdate_LeaveTournamentRequest); ok {
+		return x.LeaveTournamentRequest
+	}
+	return nil
 }
 
-type ClientUpdate_RematchRequest struct {
-	RematchRequest *RematchRequest `protobuf:"bytes,9,opt,name=rematch_request,json=rematchRequest,proto3,oneof"`
+func (x *ClientUpdate) GetWatchTournamentRequest() *WatchTournamentRequest {
+	if x, ok := x.GetType().(*ClientUpdate_WatchTournamentRequest); ok {
+		return x.WatchTournamentRequest
+	}
+	return nil
 }
 
-type ClientUpdate_ChangePlayerDisplayNameRequest struct {
-	ChangePlayerDisplayNameRequest *ChangePlayerDisplayNameRequest `protobuf:"bytes,10,opt,name=change_player_display_name_request,json=changePlayerDisplayNameRequest,proto3,oneof"`
+func (x *ClientUpdate) GetGetTournamentRequest() *GetTournamentRequest {
+	if x, ok := x.GetType().(*ClientUpdate_GetTournamentRequest); ok {
+		return x.GetTournamentRequest
+	}
+	return nil
 }
 
-type ClientUpdate_LobbySearchRequest struct {
-	LobbySearchRequest *LobbySearchRequest `protobuf:"bytes,11,opt,name=lobby_search_request,json=lobbySearchRequest,proto3,oneof"`
+func (x *ClientUpdate) GetAddFriendRequest() *AddFriendRequest {
+	if x, ok := x.GetType().(*ClientUpdate_AddFriendRequest); ok {
+		return x.AddFriendRequest
+	}
+	return nil
 }
 
-func (*ClientUpdate_SignUpRequest) isClientUpdate_Type() {}
+func (x *ClientUpdate) GetRemoveFriendRequest() *RemoveFriendRequest {
+	if x, ok := x.GetType().(*ClientUpdate_RemoveFriendRequest); ok {
+		return x.RemoveFriendRequest
+	}
+	return nil
+}
+
+func (x *ClientUpdate) GetRespondFriendRequest() *RespondFriendRequest {
+	if x, ok := x.GetType().(*ClientUpdate_RespondFriendRequest); ok {
+		return x.RespondFriendRequest
+	}
+	return nil
+}
+
+func (x *ClientUpdate) GetChallengePlayerRequest() *ChallengePlayerRequest {
+	if x, ok := x.GetType().(*ClientUpdate_ChallengePlayerRequest); ok {
+		return x.ChallengePlayerRequest
+	}
+	return nil
+}
+
+func (x *ClientUpdate) GetRespondChallengeRequest() *RespondChallengeRequest {
+	if x, ok := x.GetType().(*ClientUpdate_RespondChallengeRequest); ok {
+		return x.RespondChallengeRequest
+	}
+	return nil
+}
+
+func (x *ClientUpdate) GetCancelChallengeRequest() *CancelChallengeRequest {
+	if x, ok := x.GetType().(*ClientUpdate_CancelChallengeRequest); ok {
+		return x.CancelChallengeRequest
+	}
+	return nil
+}
+
+func (x *ClientUpdate) GetBlockPlayerRequest() *BlockPlayerRequest {
+	if x, ok := x.GetType().(*ClientUpdate_BlockPlayerRequest); ok {
+		return x.BlockPlayerRequest
+	}
+	return nil
+}
+
+func (x *ClientUpdate) GetUnblockPlayerRequest() *UnblockPlayerRequest {
+	if x, ok := x.GetType().(*ClientUpdate_UnblockPlayerRequest); ok {
+		return x.UnblockPlayerRequest
+	}
+	return nil
+}
+
+func (x *ClientUpdate) GetChangePasswordRequest() *ChangePasswordRequest {
+	if x, ok := x.GetType().(*ClientUpdate_ChangePasswordRequest); ok {
+		return x.ChangePasswordRequest
+	}
+	return nil
+}
+
+func (x *ClientUpdate) GetChangeUsernameRequest() *ChangeUsernameRequest {
+	if x, ok := x.GetType().(*ClientUpdate_ChangeUsernameRequest); ok {
+		return x.ChangeUsernameRequest
+	}
+	return nil
+}
+
+func (x *ClientUpdate) GetDeleteAccountRequest() *DeleteAccountRequest {
+	if x, ok := x.GetType().(*ClientUpdate_DeleteAccountRequest); ok {
+		return x.DeleteAccountRequest
+	}
+	return nil
+}
+
+func (x *ClientUpdate) GetPlayAsGuestRequest() *PlayAsGuestRequest {
+	if x, ok := x.GetType().(*ClientUpdate_PlayAsGuestRequest); ok {
+		return x.PlayAsGuestRequest
+	}
+	return nil
+}
+
+type isClientUpdate_Type interface {
+	isClientUpdate_Type()
+}
+
+type ClientUpdate_SignUpRequest struct {
+	SignUpRequest *SignUpRequest `protobuf:"bytes,1,opt,name=sign_up_request,json=signUpRequest,proto3,oneof"`
+}
+
+type ClientUpdate_SignInRequest struct {
+	SignInRequest *SignInRequest `protobuf:"bytes,2,opt,name=sign_in_request,json=signInRequest,proto3,oneof"`
+}
+
+type ClientUpdate_SignOutRequest struct {
+	SignOutRequest *SignOutRequest `protobuf:"bytes,3,opt,name=sign_out_request,json=signOutRequest,proto3,oneof"`
+}
+
+type ClientUpdate_CreateLobbyRequest struct {
+	CreateLobbyRequest *CreateLobbyRequest `protobuf:"bytes,4,opt,name=create_lobby_request,json=createLobbyRequest,proto3,oneof"`
+}
+
+type ClientUpdate_JoinLobbyRequest struct {
+	JoinLobbyRequest *JoinLobbyRequest `protobuf:"bytes,5,opt,name=join_lobby_request,json=joinLobbyRequest,proto3,oneof"`
+}
+
+type ClientUpdate_LeaveMyLobbyRequest struct {
+	LeaveMyLobbyRequest *LeaveMyLobbyRequest `protobuf:"bytes,6,opt,name=leave_my_lobby_request,json=leaveMyLobbyRequest,proto3,oneof"`
+}
+
+type ClientUpdate_CreateGameRequest struct {
+	CreateGameRequest *CreateGameRequest `protobuf:"bytes,7,opt,name=create_game_request,json=createGameRequest,proto3,oneof"`
+}
+
+type ClientUpdate_MakeMoveRequest struct {
+	MakeMoveRequest *MakeMoveRequest `protobuf:"bytes,8,opt,name=make_move_request,json=makeMoveRequest,proto3,oneof"`
+}
+
+type ClientUpdate_RematchRequest struct {
+	RematchRequest *RematchRequest `protobuf:"bytes,9,opt,name=rematch_request,json=rematchRequest,proto3,oneof"`
+}
+
+type ClientUpdate_ChangePlayerDisplayNameRequest struct {
+	ChangePlayerDisplayNameRequest *ChangePlayerDisplayNameRequest `protobuf:"bytes,10,opt,name=change_player_display_name_request,json=changePlayerDisplayNameRequest,proto3,oneof"`
+}
+
+type ClientUpdate_LobbySearchRequest struct {
+	LobbySearchRequest *LobbySearchRequest `protobuf:"bytes,11,opt,name=lobby_search_request,json=lobbySearchRequest,proto3,oneof"`
+}
+
+type ClientUpdate_InviteToLobbyRequest struct {
+	InviteToLobbyRequest *InviteToLobbyRequest `protobuf:"bytes,12,opt,name=invite_to_lobby_request,json=inviteToLobbyRequest,proto3,oneof"`
+}
+
+type ClientUpdate_RespondLobbyInvitationRequest struct {
+	RespondLobbyInvitationRequest *RespondLobbyInvitationRequest `protobuf:"bytes,13,opt,name=respond_lobby_invitation_request,json=respondLobbyInvitationRequest,proto3,oneof"`
+}
+
+type ClientUpdate_SetReadyRequest struct {
+	SetReadyRequest *SetReadyRequest `protobuf:"bytes,14,opt,name=set_ready_request,json=setReadyRequest,proto3,oneof"`
+}
+
+type ClientUpdate_SetLobbyModeRequest struct {
+	SetLobbyModeRequest *SetLobbyModeRequest `protobuf:"bytes,15,opt,name=set_lobby_mode_request,json=setLobbyModeRequest,proto3,oneof"`
+}
+
+type ClientUpdate_JoinPlayQueueRequest struct {
+	JoinPlayQueueRequest *JoinPlayQueueRequest `protobuf:"bytes,16,opt,name=join_play_queue_request,json=joinPlayQueueRequest,proto3,oneof"`
+}
+
+type ClientUpdate_LeavePlayQueueRequest struct {
+	LeavePlayQueueRequest *LeavePlayQueueRequest `protobuf:"bytes,17,opt,name=leave_play_queue_request,json=leavePlayQueueRequest,proto3,oneof"`
+}
+
+type ClientUpdate_CreateTournamentRequest struct {
+	CreateTournamentRequest *CreateTournamentRequest `protobuf:"bytes,18,opt,name=create_tournament_request,json=createTournamentRequest,proto3,oneof"`
+}
+
+type ClientUpdate_JoinTournamentRequest struct {
+	JoinTournamentRequest *JoinTournamentRequest `protobuf:"bytes,19,opt,name=join_tournament_request,json=joinTournamentRequest,proto3,oneof"`
+}
+
+type ClientUpdate_LeaveTournamentRequest struct {
+	LeaveTournamentRequest *LeaveTournamentRequest `protobuf:"bytes,20,opt,name=leave_tournament_request,json=leaveTournamentRequest,proto3,oneof"`
+}
+
+type ClientUpdate_WatchTournamentRequest struct {
+	WatchTournamentRequest *WatchTournamentRequest `protobuf:"bytes,21,opt,name=watch_tournament_request,json=watchTournamentRequest,proto3,oneof"`
+}
+
+type ClientUpdate_GetTournamentRequest struct {
+	GetTournamentRequest *GetTournamentRequest `protobuf:"bytes,22,opt,name=get_tournament_request,json=getTournamentRequest,proto3,oneof"`
+}
+
+type ClientUpdate_AddFriendRequest struct {
+	AddFriendRequest *AddFriendRequest `protobuf:"bytes,23,opt,name=add_friend_request,json=addFriendRequest,proto3,oneof"`
+}
+
+type ClientUpdate_RemoveFriendRequest struct {
+	RemoveFriendRequest *RemoveFriendRequest `protobuf:"bytes,24,opt,name=remove_friend_request,json=removeFriendRequest,proto3,oneof"`
+}
+
+type ClientUpdate_RespondFriendRequest struct {
+	RespondFriendRequest *RespondFriendRequest `protobuf:"bytes,25,opt,name=respond_friend_request,json=respondFriendRequest,proto3,oneof"`
+}
+
+type ClientUpdate_ChallengePlayerRequest struct {
+	ChallengePlayerRequest *ChallengePlayerRequest `protobuf:"bytes,26,opt,name=challenge_player_request,json=challengePlayerRequest,proto3,oneof"`
+}
+
+type ClientUpdate_RespondChallengeRequest struct {
+	RespondChallengeRequest *RespondChallengeRequest `protobuf:"bytes,27,opt,name=respond_challenge_request,json=respondChallengeRequest,proto3,oneof"`
+}
+
+type ClientUpdate_CancelChallengeRequest struct {
+	CancelChallengeRequest *CancelChallengeRequest `protobuf:"bytes,28,opt,name=cancel_challenge_request,json=cancelChallengeRequest,proto3,oneof"`
+}
+
+type ClientUpdate_BlockPlayerRequest struct {
+	BlockPlayerRequest *BlockPlayerRequest `protobuf:"bytes,29,opt,name=block_player_request,json=blockPlayerRequest,proto3,oneof"`
+}
+
+type ClientUpdate_UnblockPlayerRequest struct {
+	UnblockPlayerRequest *UnblockPlayerRequest `protobuf:"bytes,30,opt,name=unblock_player_request,json=unblockPlayerRequest,proto3,oneof"`
+}
+
+type ClientUpdate_ChangePasswordRequest struct {
+	ChangePasswordRequest *ChangePasswordRequest `protobuf:"bytes,31,opt,name=change_password_request,json=changePasswordRequest,proto3,oneof"`
+}
+
+type ClientUpdate_ChangeUsernameRequest struct {
+	ChangeUsernameRequest *ChangeUsernameRequest `protobuf:"bytes,32,opt,name=change_username_request,json=changeUsernameRequest,proto3,oneof"`
+}
+
+type ClientUpdate_DeleteAccountRequest struct {
+	DeleteAccountRequest *DeleteAccountRequest `protobuf:"bytes,33,opt,name=delete_account_request,json=deleteAccountRequest,proto3,oneof"`
+}
+
+type ClientUpdate_PlayAsGuestRequest struct {
+	PlayAsGuestRequest *PlayAsGuestRequest `protobuf:"bytes,34,opt,name=play_as_guest_request,json=playAsGuestRequest,proto3,oneof"`
+}
+
+func (*ClientUpdate_SignUpRequest) isClientUpdate_Type() {}
 
 func (*ClientUpdate_SignInRequest) isClientUpdate_Type() {}
 
@@ -411,6 +1091,52 @@ func (*ClientUpdate_ChangePlayerDisplayNameRequest) isClientUpdate_Type() {}
 
 func (*ClientUpdate_LobbySearchRequest) isClientUpdate_Type() {}
 
+func (*ClientUpdate_InviteToLobbyRequest) isClientUpdate_Type() {}
+
+func (*ClientUpdate_RespondLobbyInvitationRequest) isClientUpdate_Type() {}
+
+func (*ClientUpdate_SetReadyRequest) isClientUpdate_Type() {}
+
+func (*ClientUpdate_SetLobbyModeRequest) isClientUpdate_Type() {}
+
+func (*ClientUpdate_JoinPlayQueueRequest) isClientUpdate_Type() {}
+
+func (*ClientUpdate_LeavePlayQueueRequest) isClientUpdate_Type() {}
+
+func (*ClientUpdate_CreateTournamentRequest) isClientUpdate_Type() {}
+
+func (*ClientUpdate_JoinTournamentRequest) isClientUpdate_Type() {}
+
+func (*ClientUpdate_LeaveTournamentRequest) isClientUpdate_Type() {}
+
+func (*ClientUpdate_WatchTournamentRequest) isClientUpdate_Type() {}
+
+func (*ClientUpdate_GetTournamentRequest) isClientUpdate_Type() {}
+
+func (*ClientUpdate_AddFriendRequest) isClientUpdate_Type() {}
+
+func (*ClientUpdate_RemoveFriendRequest) isClientUpdate_Type() {}
+
+func (*ClientUpdate_RespondFriendRequest) isClientUpdate_Type() {}
+
+func (*ClientUpdate_ChallengePlayerRequest) isClientUpdate_Type() {}
+
+func (*ClientUpdate_RespondChallengeRequest) isClientUpdate_Type() {}
+
+func (*ClientUpdate_CancelChallengeRequest) isClientUpdate_Type() {}
+
+func (*ClientUpdate_BlockPlayerRequest) isClientUpdate_Type() {}
+
+func (*ClientUpdate_UnblockPlayerRequest) isClientUpdate_Type() {}
+
+func (*ClientUpdate_ChangePasswordRequest) isClientUpdate_Type() {}
+
+func (*ClientUpdate_ChangeUsernameRequest) isClientUpdate_Type() {}
+
+func (*ClientUpdate_DeleteAccountRequest) isClientUpdate_Type() {}
+
+func (*ClientUpdate_PlayAsGuestRequest) isClientUpdate_Type() {}
+
 type ServerUpdate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -445,7 +1171,46 @@ type ServerUpdate struct {
 	//	*ServerUpdate_ChangePlayerDisplayNameReply
 	//	*ServerUpdate_LobbySearchReply
 	//	*ServerUpdate_LobbySearchResult
-	Type isServerUpdate_Type `protobuf_oneof:"type"`
+	//	*ServerUpdate_InviteToLobbyReply
+	//	*ServerUpdate_LobbyInvitationUpdate
+	//	*ServerUpdate_RespondLobbyInvitationReply
+	//	*ServerUpdate_LobbyInvitationResponseUpdate
+	//	*ServerUpdate_SetReadyReply
+	//	*ServerUpdate_LobbyReadyStateUpdate
+	//	*ServerUpdate_SetLobbyModeReply
+	//	*ServerUpdate_JoinPlayQueueReply
+	//	*ServerUpdate_LeavePlayQueueReply
+	//	*ServerUpdate_PlayQueueUpdate
+	//	*ServerUpdate_CreateTournamentReply
+	//	*ServerUpdate_JoinTournamentReply
+	//	*ServerUpdate_LeaveTournamentReply
+	//	*ServerUpdate_WatchTournamentReply
+	//	*ServerUpdate_GetTournamentReply
+	//	*ServerUpdate_TournamentBracketUpdate
+	//	*ServerUpdate_SeriesScoreUpdate
+	//	*ServerUpdate_RematchCountdownUpdate
+	//	*ServerUpdate_AddFriendReply
+	//	*ServerUpdate_RemoveFriendReply
+	//	*ServerUpdate_RespondFriendReply
+	//	*ServerUpdate_FriendListUpdate
+	//	*ServerUpdate_FriendPresenceUpdate
+	//	*ServerUpdate_ChallengePlayerReply
+	//	*ServerUpdate_ChallengeReceivedUpdate
+	//	*ServerUpdate_RespondChallengeReply
+	//	*ServerUpdate_CancelChallengeReply
+	//	*ServerUpdate_ChallengeResponseUpdate
+	//	*ServerUpdate_BlockPlayerReply
+	//	*ServerUpdate_UnblockPlayerReply
+	//	*ServerUpdate_BlockListUpdate
+	//	*ServerUpdate_ChangePasswordReply
+	//	*ServerUpdate_ChangeUsernameReply
+	//	*ServerUpdate_DeleteAccountReply
+	//	*ServerUpdate_PlayerRenamedUpdate
+	//	*ServerUpdate_PlayAsGuestReply
+	//	*ServerUpdate_RateLimitUpdate
+	//	*ServerUpdate_SystemAnnouncementUpdate
+	Type          isServerUpdate_Type `protobuf_oneof:"type"`
+	CorrelationId string              `protobuf:"bytes,100,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
 }
 
 func (x *ServerUpdate) Reset() {
@@ -683,103 +1448,376 @@ func (x *ServerUpdate) GetLobbySearchResult() *LobbySearchResult {
 	return nil
 }
 
-type isServerUpdate_Type interface {
-	isServerUpdate_Type()
+func (x *ServerUpdate) GetInviteToLobbyReply() *InviteToLobbyReply {
+	if x, ok := x.GetType().(*ServerUpdate_InviteToLobbyReply); ok {
+		return x.InviteToLobbyReply
+	}
+	return nil
 }
 
-type ServerUpdate_Ping struct {
-	Ping *Ping `protobuf:"bytes,1,opt,name=ping,proto3,oneof"`
+func (x *ServerUpdate) GetLobbyInvitationUpdate() *LobbyInvitationUpdate {
+	if x, ok := x.GetType().(*ServerUpdate_LobbyInvitationUpdate); ok {
+		return x.LobbyInvitationUpdate
+	}
+	return nil
 }
 
-type ServerUpdate_ClientAssignmentUpdate struct {
-	ClientAssignmentUpdate *ClientAssignmentUpdate `protobuf:"bytes,2,opt,name=client_assignment_update,json=clientAssignmentUpdate,proto3,oneof"`
+func (x *ServerUpdate) GetRespondLobbyInvitationReply() *RespondLobbyInvitationReply {
+	if x, ok := x.GetType().(*ServerUpdate_RespondLobbyInvitationReply); ok {
+		return x.RespondLobbyInvitationReply
+	}
+	return nil
 }
 
-type ServerUpdate_NavigationUpdate struct {
-	NavigationUpdate *NavigationUpdate `protobuf:"bytes,3,opt,name=navigation_update,json=navigationUpdate,proto3,oneof"`
+func (x *ServerUpdate) GetLobbyInvitationResponseUpdate() *LobbyInvitationResponseUpdate {
+	if x, ok := x.GetType().(*ServerUpdate_LobbyInvitationResponseUpdate); ok {
+		return x.LobbyInvitationResponseUpdate
+	}
+	return nil
 }
 
-type ServerUpdate_SignUpReply struct {
-	SignUpReply *SignUpReply `protobuf:"bytes,4,opt,name=sign_up_reply,json=signUpReply,proto3,oneof"`
+func (x *ServerUpdate) GetSetReadyReply() *SetReadyReply {
+	if x, ok := x.GetType().(*ServerUpdate_SetReadyReply); ok {
+		return x.SetReadyReply
+	}
+	return nil
 }
 
-type ServerUpdate_SignInReply struct {
-	SignInReply *SignInReply `protobuf:"bytes,5,opt,name=sign_in_reply,json=signInReply,proto3,oneof"`
+func (x *ServerUpdate) GetLobbyReadyStateUpdate() *LobbyReadyStateUpdate {
+	if x, ok := x.GetType().(*ServerUpdate_LobbyReadyStateUpdate); ok {
+		return x.LobbyReadyStateUpdate
+	}
+	return nil
 }
 
-type ServerUpdate_SignOutReply struct {
-	SignOutReply *SignOutReply `protobuf:"bytes,6,opt,name=sign_out_reply,json=signOutReply,proto3,oneof"`
+func (x *ServerUpdate) GetSetLobbyModeReply() *SetLobbyModeReply {
+	if x, ok := x.GetType().(*ServerUpdate_SetLobbyModeReply); ok {
+		return x.SetLobbyModeReply
+	}
+	return nil
 }
 
-type ServerUpdate_MyLobbyDetails struct {
-	MyLobbyDetails *MyLobbyDetails `protobuf:"bytes,7,opt,name=my_lobby_details,json=myLobbyDetails,proto3,oneof"`
+func (x *ServerUpdate) GetJoinPlayQueueReply() *JoinPlayQueueReply {
+	if x, ok := x.GetType().(*ServerUpdate_JoinPlayQueueReply); ok {
+		return x.JoinPlayQueueReply
+	}
+	return nil
 }
 
-type ServerUpdate_MyLobbyJoinerUpdate struct {
-	MyLobbyJoinerUpdate *MyLobbyJoinerUpdate `protobuf:"bytes,8,opt,name=my_lobby_joiner_update,json=myLobbyJoinerUpdate,proto3,oneof"`
+func (x *ServerUpdate) GetLeavePlayQueueReply() *LeavePlayQueueReply {
+	if x, ok := x.GetType().(*ServerUpdate_LeavePlayQueueReply); ok {
+		return x.LeavePlayQueueReply
+	}
+	return nil
 }
 
-type ServerUpdate_MyLobbyLeaverUpdate struct {
-	MyLobbyLeaverUpdate *MyLobbyLeaverUpdate `protobuf:"bytes,9,opt,name=my_lobby_leaver_update,json=myLobbyLeaverUpdate,proto3,oneof"`
+func (x *ServerUpdate) GetPlayQueueUpdate() *PlayQueueUpdate {
+	if x, ok := x.GetType().(*ServerUpdate_PlayQueueUpdate); ok {
+		return x.PlayQueueUpdate
+	}
+	return nil
 }
 
-type ServerUpdate_CreateLobbyReply struct {
-	CreateLobbyReply *CreateLobbyReply `protobuf:"bytes,10,opt,name=create_lobby_reply,json=createLobbyReply,proto3,oneof"`
+func (x *ServerUpdate) GetCreateTournamentReply() *CreateTournamentReply {
+	if x, ok := x.GetType().(*ServerUpdate_CreateTournamentReply); ok {
+		return x.CreateTournamentReply
+	}
+	return nil
 }
 
-type ServerUpdate_JoinLobbyReply struct {
-	JoinLobbyReply *JoinLobbyReply `protobuf:"bytes,11,opt,name=join_lobby_reply,json=joinLobbyReply,proto3,oneof"`
+func (x *ServerUpdate) GetJoinTournamentReply() *JoinTournamentReply {
+	if x, ok := x.GetType().(*ServerUpdate_JoinTournamentReply); ok {
+		return x.JoinTournamentReply
+	}
+	return nil
 }
 
-type ServerUpdate_LeaveMyLobbyReply struct {
-	LeaveMyLobbyReply *LeaveMyLobbyReply `protobuf:"bytes,12,opt,name=leave_my_lobby_reply,json=leaveMyLobbyReply,proto3,oneof"`
+func (x *ServerUpdate) GetLeaveTournamentReply() *LeaveTournamentReply {
+	if x, ok := x.GetType().(*ServerUpdate_LeaveTournamentReply); ok {
+		return x.LeaveTournamentReply
+	}
+	return nil
 }
 
-type ServerUpdate_CreateGameReply struct {
-	CreateGameReply *CreateGameReply `protobuf:"bytes,13,opt,name=create_game_reply,json=createGameReply,proto3,oneof"`
+func (x *ServerUpdate) GetWatchTournamentReply() *WatchTournamentReply {
+	if x, ok := x.GetType().(*ServerUpdate_WatchTournamentReply); ok {
+		return x.WatchTournamentReply
+	}
+	return nil
 }
 
-type ServerUpdate_MakeMoveReply struct {
-	MakeMoveReply *MakeMoveReply `protobuf:"bytes,14,opt,name=make_move_reply,json=makeMoveReply,proto3,oneof"`
+func (x *ServerUpdate) GetGetTournamentReply() *GetTournamentReply {
+	if x, ok := x.GetType().(*ServerUpdate_GetTournamentReply); ok {
+		return x.GetTournamentReply
+	}
+	return nil
 }
 
-type ServerUpdate_MoveUpdate struct {
-	MoveUpdate *MoveUpdate `protobuf:"bytes,15,opt,name=move_update,json=moveUpdate,proto3,oneof"`
+func (x *ServerUpdate) GetTournamentBracketUpdate() *TournamentBracketUpdate {
+	if x, ok := x.GetType().(*ServerUpdate_TournamentBracketUpdate); ok {
+		return x.TournamentBracketUpdate
+	}
+	return nil
 }
 
-type ServerUpdate_WinnerUpdate struct {
-	WinnerUpdate *WinnerUpdate `protobuf:"bytes,16,opt,name=winner_update,json=winnerUpdate,proto3,oneof"`
+func (x *ServerUpdate) GetSeriesScoreUpdate() *SeriesScoreUpdate {
+	if x, ok := x.GetType().(*ServerUpdate_SeriesScoreUpdate); ok {
+		return x.SeriesScoreUpdate
+	}
+	return nil
 }
 
-type ServerUpdate_DrawUpdate struct {
-	DrawUpdate *DrawUpdate `protobuf:"bytes,17,opt,name=draw_update,json=drawUpdate,proto3,oneof"`
+func (x *ServerUpdate) GetRematchCountdownUpdate() *RematchCountdownUpdate {
+	if x, ok := x.GetType().(*ServerUpdate_RematchCountdownUpdate); ok {
+		return x.RematchCountdownUpdate
+	}
+	return nil
 }
 
-type ServerUpdate_GameStartUpdate struct {
-	GameStartUpdate *GameStartUpdate `protobuf:"bytes,18,opt,name=game_start_update,json=gameStartUpdate,proto3,oneof"`
+func (x *ServerUpdate) GetAddFriendReply() *AddFriendReply {
+	if x, ok := x.GetType().(*ServerUpdate_AddFriendReply); ok {
+		return x.AddFriendReply
+	}
+	return nil
 }
 
-type ServerUpdate_NextMoverUpdate struct {
-	NextMoverUpdate *NextMoverUpdate `protobuf:"bytes,19,opt,name=next_mover_update,json=nextMoverUpdate,proto3,oneof"`
+func (x *ServerUpdate) GetRemoveFriendReply() *RemoveFriendReply {
+	if x, ok := x.GetType().(*ServerUpdate_RemoveFriendReply); ok {
+		return x.RemoveFriendReply
+	}
+	return nil
 }
 
-type ServerUpdate_PlayerClientUpdate struct {
-	PlayerClientUpdate *PlayerClientUpdate `protobuf:"bytes,20,opt,name=player_client_update,json=playerClientUpdate,proto3,oneof"`
+func (x *ServerUpdate) GetRespondFriendReply() *RespondFriendReply {
+	if x, ok := x.GetType().(*ServerUpdate_RespondFriendReply); ok {
+		return x.RespondFriendReply
+	}
+	return nil
 }
 
-type ServerUpdate_PlayerDisplayNameUpdate struct {
-	PlayerDisplayNameUpdate *PlayerDisplayNameUpdate `protobuf:"bytes,21,opt,name=player_display_name_update,json=playerDisplayNameUpdate,proto3,oneof"`
+func (x *ServerUpdate) GetFriendListUpdate() *FriendListUpdate {
+	if x, ok := x.GetType().(*ServerUpdate_FriendListUpdate); ok {
+		return x.FriendListUpdate
+	}
+	return nil
 }
 
-type ServerUpdate_RematchReply struct {
-	RematchReply *RematchReply `protobuf:"bytes,22,opt,name=rematch_reply,json=rematchReply,proto3,oneof"`
+func (x *ServerUpdate) GetFriendPresenceUpdate() *FriendPresenceUpdate {
+	if x, ok := x.GetType().(*ServerUpdate_FriendPresenceUpdate); ok {
+		return x.FriendPresenceUpdate
+	}
+	return nil
 }
 
-type ServerUpdate_RematchDenied struct {
-	RematchDenied *RematchDenied `protobuf:"bytes,23,opt,name=rematch_denied,json=rematchDenied,proto3,oneof"`
+func (x *ServerUpdate) GetChallengePlayerReply() *ChallengePlayerReply {
+	if x, ok := x.GetType().(*ServerUpdate_ChallengePlayerReply); ok {
+		return x.ChallengePlayerReply
+	}
+	return nil
 }
 
-type ServerUpdate_RematchApproved struct {
+func (x *ServerUpdate) GetChallengeReceivedUpdate() *ChallengeReceivedUpdate {
+	if x, ok := x.GetType().(*ServerUpdate_ChallengeReceivedUpdate); ok {
+		return x.ChallengeReceivedUpdate
+	}
+	return nil
+}
+
+func (x *ServerUpdate) GetRespondChallengeReply() *RespondChallengeReply {
+	if x, ok := x.GetType().(*ServerUpdate_RespondChallengeReply); ok {
+		return x.RespondChallengeReply
+	}
+	return nil
+}
+
+func (x *ServerUpdate) GetCancelChallengeReply() *CancelChallengeReply {
+	if x, ok := x.GetType().(*ServerUpdate_CancelChallengeReply); ok {
+		return x.CancelChallengeReply
+	}
+	return nil
+}
+
+func (x *ServerUpdate) GetChallengeResponseUpdate() *ChallengeResponseUpdate {
+	if x, ok := x.GetType().(*ServerUpdate_ChallengeResponseUpdate); ok {
+		return x.ChallengeResponseUpdate
+	}
+	return nil
+}
+
+func (x *ServerUpdate) GetBlockPlayerReply() *BlockPlayerReply {
+	if x, ok := x.GetType().(*ServerUpdate_BlockPlayerReply); ok {
+		return x.BlockPlayerReply
+	}
+	return nil
+}
+
+func (x *ServerUpdate) GetUnblockPlayerReply() *UnblockPlayerReply {
+	if x, ok := x.GetType().(*ServerUpdate_UnblockPlayerReply); ok {
+		return x.UnblockPlayerReply
+	}
+	return nil
+}
+
+func (x *ServerUpdate) GetBlockListUpdate() *BlockListUpdate {
+	if x, ok := x.GetType().(*ServerUpdate_BlockListUpdate); ok {
+		return x.BlockListUpdate
+	}
+	return nil
+}
+
+func (x *ServerUpdate) GetChangePasswordReply() *ChangePasswordReply {
+	if x, ok := x.GetType().(*ServerUpdate_ChangePasswordReply); ok {
+		return x.ChangePasswordReply
+	}
+	return nil
+}
+
+func (x *ServerUpdate) GetChangeUsernameReply() *ChangeUsernameReply {
+	if x, ok := x.GetType().(*ServerUpdate_ChangeUsernameReply); ok {
+		return x.ChangeUsernameReply
+	}
+	return nil
+}
+
+func (x *ServerUpdate) GetDeleteAccountReply() *DeleteAccountReply {
+	if x, ok := x.GetType().(*ServerUpdate_DeleteAccountReply); ok {
+		return x.DeleteAccountReply
+	}
+	return nil
+}
+
+func (x *ServerUpdate) GetPlayerRenamedUpdate() *PlayerRenamedUpdate {
+	if x, ok := x.GetType().(*ServerUpdate_PlayerRenamedUpdate); ok {
+		return x.PlayerRenamedUpdate
+	}
+	return nil
+}
+
+func (x *ServerUpdate) GetPlayAsGuestReply() *PlayAsGuestReply {
+	if x, ok := x.GetType().(*ServerUpdate_PlayAsGuestReply); ok {
+		return x.PlayAsGuestReply
+	}
+	return nil
+}
+
+func (x *ServerUpdate) GetRateLimitUpdate() *RateLimitUpdate {
+	if x, ok := x.GetType().(*ServerUpdate_RateLimitUpdate); ok {
+		return x.RateLimitUpdate
+	}
+	return nil
+}
+
+func (x *ServerUpdate) GetSystemAnnouncementUpdate() *SystemAnnouncementUpdate {
+	if x, ok := x.GetType().(*ServerUpdate_SystemAnnouncementUpdate); ok {
+		return x.SystemAnnouncementUpdate
+	}
+	return nil
+}
+
+func (x *ServerUpdate) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+type isServerUpdate_Type interface {
+	isServerUpdate_Type()
+}
+
+type ServerUpdate_Ping struct {
+	Ping *Ping `protobuf:"bytes,1,opt,name=ping,proto3,oneof"`
+}
+
+type ServerUpdate_ClientAssignmentUpdate struct {
+	ClientAssignmentUpdate *ClientAssignmentUpdate `protobuf:"bytes,2,opt,name=client_assignment_update,json=clientAssignmentUpdate,proto3,oneof"`
+}
+
+type ServerUpdate_NavigationUpdate struct {
+	NavigationUpdate *NavigationUpdate `protobuf:"bytes,3,opt,name=navigation_update,json=navigationUpdate,proto3,oneof"`
+}
+
+type ServerUpdate_SignUpReply struct {
+	SignUpReply *SignUpReply `protobuf:"bytes,4,opt,name=sign_up_reply,json=signUpReply,proto3,oneof"`
+}
+
+type ServerUpdate_SignInReply struct {
+	SignInReply *SignInReply `protobuf:"bytes,5,opt,name=sign_in_reply,json=signInReply,proto3,oneof"`
+}
+
+type ServerUpdate_SignOutReply struct {
+	SignOutReply *SignOutReply `protobuf:"bytes,6,opt,name=sign_out_reply,json=signOutReply,proto3,oneof"`
+}
+
+type ServerUpdate_MyLobbyDetails struct {
+	MyLobbyDetails *MyLobbyDetails `protobuf:"bytes,7,opt,name=my_lobby_details,json=myLobbyDetails,proto3,oneof"`
+}
+
+type ServerUpdate_MyLobbyJoinerUpdate struct {
+	MyLobbyJoinerUpdate *MyLobbyJoinerUpdate `protobuf:"bytes,8,opt,name=my_lobby_joiner_update,json=myLobbyJoinerUpdate,proto3,oneof"`
+}
+
+type ServerUpdate_MyLobbyLeaverUpdate struct {
+	MyLobbyLeaverUpdate *MyLobbyLeaverUpdate `protobuf:"bytes,9,opt,name=my_lobby_leaver_update,json=myLobbyLeaverUpdate,proto3,oneof"`
+}
+
+type ServerUpdate_CreateLobbyReply struct {
+	CreateLobbyReply *CreateLobbyReply `protobuf:"bytes,10,opt,name=create_lobby_reply,json=createLobbyReply,proto3,oneof"`
+}
+
+type ServerUpdate_JoinLobbyReply struct {
+	JoinLobbyReply *JoinLobbyReply `protobuf:"bytes,11,opt,name=join_lobby_reply,json=joinLobbyReply,proto3,oneof"`
+}
+
+type ServerUpdate_LeaveMyLobbyReply struct {
+	LeaveMyLobbyReply *LeaveMyLobbyReply `protobuf:"bytes,12,opt,name=leave_my_lobby_reply,json=leaveMyLobbyReply,proto3,oneof"`
+}
+
+type ServerUpdate_CreateGameReply struct {
+	CreateGameReply *CreateGameReply `protobuf:"bytes,13,opt,name=create_game_reply,json=createGameReply,proto3,oneof"`
+}
+
+type ServerUpdate_MakeMoveReply struct {
+	MakeMoveReply *MakeMoveReply `protobuf:"bytes,14,opt,name=make_move_reply,json=makeMoveReply,proto3,oneof"`
+}
+
+type ServerUpdate_MoveUpdate struct {
+	MoveUpdate *MoveUpdate `protobuf:"bytes,15,opt,name=move_update,json=moveUpdate,proto3,oneof"`
+}
+
+type ServerUpdate_WinnerUpdate struct {
+	WinnerUpdate *WinnerUpdate `protobuf:"bytes,16,opt,name=winner_update,json=winnerUpdate,proto3,oneof"`
+}
+
+type ServerUpdate_DrawUpdate struct {
+	DrawUpdate *DrawUpdate `protobuf:"bytes,17,opt,name=draw_update,json=drawUpdate,proto3,oneof"`
+}
+
+type ServerUpdate_GameStartUpdate struct {
+	GameStartUpdate *GameStartUpdate `protobuf:"bytes,18,opt,name=game_start_update,json=gameStartUpdate,proto3,oneof"`
+}
+
+type ServerUpdate_NextMoverUpdate struct {
+	NextMoverUpdate *NextMoverUpdate `protobuf:"bytes,19,opt,name=next_mover_update,json=nextMoverUpdate,proto3,oneof"`
+}
+
+type ServerUpdate_PlayerClientUpdate struct {
+	PlayerClientUpdate *PlayerClientUpdate `protobuf:"bytes,20,opt,name=player_client_update,json=playerClientUpdate,proto3,oneof"`
+}
+
+type ServerUpdate_PlayerDisplayNameUpdate struct {
+	PlayerDisplayNameUpdate *PlayerDisplayNameUpdate `protobuf:"bytes,21,opt,name=player_display_name_update,json=playerDisplayNameUpdate,proto3,oneof"`
+}
+
+type ServerUpdate_RematchReply struct {
+	RematchReply *RematchReply `protobuf:"bytes,22,opt,name=rematch_reply,json=rematchReply,proto3,oneof"`
+}
+
+type ServerUpdate_RematchDenied struct {
+	RematchDenied *RematchDenied `protobuf:"bytes,23,opt,name=rematch_denied,json=rematchDenied,proto3,oneof"`
+}
+
+type ServerUpdate_RematchApproved struct {
 	RematchApproved *RematchApproved `protobuf:"bytes,24,opt,name=rematch_approved,json=rematchApproved,proto3,oneof"`
 }
 
@@ -799,6 +1837,158 @@ type ServerUpdate_LobbySearchResult struct {
 	LobbySearchResult *LobbySearchResult `protobuf:"bytes,28,opt,name=lobby_search_result,json=lobbySearchResult,proto3,oneof"`
 }
 
+type ServerUpdate_InviteToLobbyReply struct {
+	InviteToLobbyReply *InviteToLobbyReply `protobuf:"bytes,29,opt,name=invite_to_lobby_reply,json=inviteToLobbyReply,proto3,oneof"`
+}
+
+type ServerUpdate_LobbyInvitationUpdate struct {
+	LobbyInvitationUpdate *LobbyInvitationUpdate `protobuf:"bytes,30,opt,name=lobby_invitation_update,json=lobbyInvitationUpdate,proto3,oneof"`
+}
+
+type ServerUpdate_RespondLobbyInvitationReply struct {
+	RespondLobbyInvitationReply *RespondLobbyInvitationReply `protobuf:"bytes,31,opt,name=respond_lobby_invitation_reply,json=respondLobbyInvitationReply,proto3,oneof"`
+}
+
+type ServerUpdate_LobbyInvitationResponseUpdate struct {
+	LobbyInvitationResponseUpdate *LobbyInvitationResponseUpdate `protobuf:"bytes,32,opt,name=lobby_invitation_response_update,json=lobbyInvitationResponseUpdate,proto3,oneof"`
+}
+
+type ServerUpdate_SetReadyReply struct {
+	SetReadyReply *SetReadyReply `protobuf:"bytes,33,opt,name=set_ready_reply,json=setReadyReply,proto3,oneof"`
+}
+
+type ServerUpdate_LobbyReadyStateUpdate struct {
+	LobbyReadyStateUpdate *LobbyReadyStateUpdate `protobuf:"bytes,34,opt,name=lobby_ready_state_update,json=lobbyReadyStateUpdate,proto3,oneof"`
+}
+
+type ServerUpdate_SetLobbyModeReply struct {
+	SetLobbyModeReply *SetLobbyModeReply `protobuf:"bytes,35,opt,name=set_lobby_mode_reply,json=setLobbyModeReply,proto3,oneof"`
+}
+
+type ServerUpdate_JoinPlayQueueReply struct {
+	JoinPlayQueueReply *JoinPlayQueueReply `protobuf:"bytes,36,opt,name=join_play_queue_reply,json=joinPlayQueueReply,proto3,oneof"`
+}
+
+type ServerUpdate_LeavePlayQueueReply struct {
+	LeavePlayQueueReply *LeavePlayQueueReply `protobuf:"bytes,37,opt,name=leave_play_queue_reply,json=leavePlayQueueReply,proto3,oneof"`
+}
+
+type ServerUpdate_PlayQueueUpdate struct {
+	PlayQueueUpdate *PlayQueueUpdate `protobuf:"bytes,38,opt,name=play_queue_update,json=playQueueUpdate,proto3,oneof"`
+}
+
+type ServerUpdate_CreateTournamentReply struct {
+	CreateTournamentReply *CreateTournamentReply `protobuf:"bytes,39,opt,name=create_tournament_reply,json=createTournamentReply,proto3,oneof"`
+}
+
+type ServerUpdate_JoinTournamentReply struct {
+	JoinTournamentReply *JoinTournamentReply `protobuf:"bytes,40,opt,name=join_tournament_reply,json=joinTournamentReply,proto3,oneof"`
+}
+
+type ServerUpdate_LeaveTournamentReply struct {
+	LeaveTournamentReply *LeaveTournamentReply `protobuf:"bytes,41,opt,name=leave_tournament_reply,json=leaveTournamentReply,proto3,oneof"`
+}
+
+type ServerUpdate_WatchTournamentReply struct {
+	WatchTournamentReply *WatchTournamentReply `protobuf:"bytes,42,opt,name=watch_tournament_reply,json=watchTournamentReply,proto3,oneof"`
+}
+
+type ServerUpdate_GetTournamentReply struct {
+	GetTournamentReply *GetTournamentReply `protobuf:"bytes,43,opt,name=get_tournament_reply,json=getTournamentReply,proto3,oneof"`
+}
+
+type ServerUpdate_TournamentBracketUpdate struct {
+	TournamentBracketUpdate *TournamentBracketUpdate `protobuf:"bytes,44,opt,name=tournament_bracket_update,json=tournamentBracketUpdate,proto3,oneof"`
+}
+
+type ServerUpdate_SeriesScoreUpdate struct {
+	SeriesScoreUpdate *SeriesScoreUpdate `protobuf:"bytes,45,opt,name=series_score_update,json=seriesScoreUpdate,proto3,oneof"`
+}
+
+type ServerUpdate_RematchCountdownUpdate struct {
+	RematchCountdownUpdate *RematchCountdownUpdate `protobuf:"bytes,46,opt,name=rematch_countdown_update,json=rematchCountdownUpdate,proto3,oneof"`
+}
+
+type ServerUpdate_AddFriendReply struct {
+	AddFriendReply *AddFriendReply `protobuf:"bytes,47,opt,name=add_friend_reply,json=addFriendReply,proto3,oneof"`
+}
+
+type ServerUpdate_RemoveFriendReply struct {
+	RemoveFriendReply *RemoveFriendReply `protobuf:"bytes,48,opt,name=remove_friend_reply,json=removeFriendReply,proto3,oneof"`
+}
+
+type ServerUpdate_RespondFriendReply struct {
+	RespondFriendReply *RespondFriendReply `protobuf:"bytes,49,opt,name=respond_friend_reply,json=respondFriendReply,proto3,oneof"`
+}
+
+type ServerUpdate_FriendListUpdate struct {
+	FriendListUpdate *FriendListUpdate `protobuf:"bytes,50,opt,name=friend_list_update,json=friendListUpdate,proto3,oneof"`
+}
+
+type ServerUpdate_FriendPresenceUpdate struct {
+	FriendPresenceUpdate *FriendPresenceUpdate `protobuf:"bytes,51,opt,name=friend_presence_update,json=friendPresenceUpdate,proto3,oneof"`
+}
+
+type ServerUpdate_ChallengePlayerReply struct {
+	ChallengePlayerReply *ChallengePlayerReply `protobuf:"bytes,52,opt,name=challenge_player_reply,json=challengePlayerReply,proto3,oneof"`
+}
+
+type ServerUpdate_ChallengeReceivedUpdate struct {
+	ChallengeReceivedUpdate *ChallengeReceivedUpdate `protobuf:"bytes,53,opt,name=challenge_received_update,json=challengeReceivedUpdate,proto3,oneof"`
+}
+
+type ServerUpdate_RespondChallengeReply struct {
+	RespondChallengeReply *RespondChallengeReply `protobuf:"bytes,54,opt,name=respond_challenge_reply,json=respondChallengeReply,proto3,oneof"`
+}
+
+type ServerUpdate_CancelChallengeReply struct {
+	CancelChallengeReply *CancelChallengeReply `protobuf:"bytes,55,opt,name=cancel_challenge_reply,json=cancelChallengeReply,proto3,oneof"`
+}
+
+type ServerUpdate_ChallengeResponseUpdate struct {
+	ChallengeResponseUpdate *ChallengeResponseUpdate `protobuf:"bytes,56,opt,name=challenge_response_update,json=challengeResponseUpdate,proto3,oneof"`
+}
+
+type ServerUpdate_BlockPlayerReply struct {
+	BlockPlayerReply *BlockPlayerReply `protobuf:"bytes,57,opt,name=block_player_reply,json=blockPlayerReply,proto3,oneof"`
+}
+
+type ServerUpdate_UnblockPlayerReply struct {
+	UnblockPlayerReply *UnblockPlayerReply `protobuf:"bytes,58,opt,name=unblock_player_reply,json=unblockPlayerReply,proto3,oneof"`
+}
+
+type ServerUpdate_BlockListUpdate struct {
+	BlockListUpdate *BlockListUpdate `protobuf:"bytes,59,opt,name=block_list_update,json=blockListUpdate,proto3,oneof"`
+}
+
+type ServerUpdate_ChangePasswordReply struct {
+	ChangePasswordReply *ChangePasswordReply `protobuf:"bytes,60,opt,name=change_password_reply,json=changePasswordReply,proto3,oneof"`
+}
+
+type ServerUpdate_ChangeUsernameReply struct {
+	ChangeUsernameReply *ChangeUsernameReply `protobuf:"bytes,61,opt,name=change_username_reply,json=changeUsernameReply,proto3,oneof"`
+}
+
+type ServerUpdate_DeleteAccountReply struct {
+	DeleteAccountReply *DeleteAccountReply `protobuf:"bytes,62,opt,name=delete_account_reply,json=deleteAccountReply,proto3,oneof"`
+}
+
+type ServerUpdate_PlayerRenamedUpdate struct {
+	PlayerRenamedUpdate *PlayerRenamedUpdate `protobuf:"bytes,63,opt,name=player_renamed_update,json=playerRenamedUpdate,proto3,oneof"`
+}
+
+type ServerUpdate_PlayAsGuestReply struct {
+	PlayAsGuestReply *PlayAsGuestReply `protobuf:"bytes,64,opt,name=play_as_guest_reply,json=playAsGuestReply,proto3,oneof"`
+}
+
+type ServerUpdate_RateLimitUpdate struct {
+	RateLimitUpdate *RateLimitUpdate `protobuf:"bytes,65,opt,name=rate_limit_update,json=rateLimitUpdate,proto3,oneof"`
+}
+
+type ServerUpdate_SystemAnnouncementUpdate struct {
+	SystemAnnouncementUpdate *SystemAnnouncementUpdate `protobuf:"bytes,66,opt,name=system_announcement_update,json=systemAnnouncementUpdate,proto3,oneof"`
+}
+
 func (*ServerUpdate_Ping) isServerUpdate_Type() {}
 
 func (*ServerUpdate_ClientAssignmentUpdate) isServerUpdate_Type() {}
@@ -855,32 +2045,108 @@ func (*ServerUpdate_LobbySearchReply) isServerUpdate_Type() {}
 
 func (*ServerUpdate_LobbySearchResult) isServerUpdate_Type() {}
 
-type Ping struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
+func (*ServerUpdate_InviteToLobbyReply) isServerUpdate_Type() {}
 
-func (x *Ping) Reset() {
-	*x = Ping{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_server2_tctxto2_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
+func (*ServerUpdate_LobbyInvitationUpdate) isServerUpdate_Type() {}
 
-func (x *Ping) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+func (*ServerUpdate_RespondLobbyInvitationReply) isServerUpdate_Type() {}
 
-func (*Ping) ProtoMessage() {}
+func (*ServerUpdate_LobbyInvitationResponseUpdate) isServerUpdate_Type() {}
 
-func (x *Ping) ProtoReflect() protoreflect.Message {
-	mi := &file_server2_tctxto2_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
+func (*ServerUpdate_SetReadyReply) isServerUpdate_Type() {}
+
+func (*ServerUpdate_LobbyReadyStateUpdate) isServerUpdate_Type() {}
+
+func (*ServerUpdate_SetLobbyModeReply) isServerUpdate_Type() {}
+
+func (*ServerUpdate_JoinPlayQueueReply) isServerUpdate_Type() {}
+
+func (*ServerUpdate_LeavePlayQueueReply) isServerUpdate_Type() {}
+
+func (*ServerUpdate_PlayQueueUpdate) isServerUpdate_Type() {}
+
+func (*ServerUpdate_CreateTournamentReply) isServerUpdate_Type() {}
+
+func (*ServerUpdate_JoinTournamentReply) isServerUpdate_Type() {}
+
+func (*ServerUpdate_LeaveTournamentReply) isServerUpdate_Type() {}
+
+func (*ServerUpdate_WatchTournamentReply) isServerUpdate_Type() {}
+
+func (*ServerUpdate_GetTournamentReply) isServerUpdate_Type() {}
+
+func (*ServerUpdate_TournamentBracketUpdate) isServerUpdate_Type() {}
+
+func (*ServerUpdate_SeriesScoreUpdate) isServerUpdate_Type() {}
+
+func (*ServerUpdate_RematchCountdownUpdate) isServerUpdate_Type() {}
+
+func (*ServerUpdate_AddFriendReply) isServerUpdate_Type() {}
+
+func (*ServerUpdate_RemoveFriendReply) isServerUpdate_Type() {}
+
+func (*ServerUpdate_RespondFriendReply) isServerUpdate_Type() {}
+
+func (*ServerUpdate_FriendListUpdate) isServerUpdate_Type() {}
+
+func (*ServerUpdate_FriendPresenceUpdate) isServerUpdate_Type() {}
+
+func (*ServerUpdate_ChallengePlayerReply) isServerUpdate_Type() {}
+
+func (*ServerUpdate_ChallengeReceivedUpdate) isServerUpdate_Type() {}
+
+func (*ServerUpdate_RespondChallengeReply) isServerUpdate_Type() {}
+
+func (*ServerUpdate_CancelChallengeReply) isServerUpdate_Type() {}
+
+func (*ServerUpdate_ChallengeResponseUpdate) isServerUpdate_Type() {}
+
+func (*ServerUpdate_BlockPlayerReply) isServerUpdate_Type() {}
+
+func (*ServerUpdate_UnblockPlayerReply) isServerUpdate_Type() {}
+
+func (*ServerUpdate_BlockListUpdate) isServerUpdate_Type() {}
+
+func (*ServerUpdate_ChangePasswordReply) isServerUpdate_Type() {}
+
+func (*ServerUpdate_ChangeUsernameReply) isServerUpdate_Type() {}
+
+func (*ServerUpdate_DeleteAccountReply) isServerUpdate_Type() {}
+
+func (*ServerUpdate_PlayerRenamedUpdate) isServerUpdate_Type() {}
+
+func (*ServerUpdate_PlayAsGuestReply) isServerUpdate_Type() {}
+
+func (*ServerUpdate_RateLimitUpdate) isServerUpdate_Type() {}
+
+func (*ServerUpdate_SystemAnnouncementUpdate) isServerUpdate_Type() {}
+
+type Ping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Ping) Reset() {
+	*x = Ping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ping) ProtoMessage() {}
+
+func (x *Ping) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
 		return ms
@@ -901,6 +2167,8 @@ type Lobby struct {
 	Id      string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name    string    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Players []*Player `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`
+	HostId  string    `protobuf:"bytes,4,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	Mode    LobbyMode `protobuf:"varint,5,opt,name=mode,proto3,enum=server2.LobbyMode" json:"mode,omitempty"`
 }
 
 func (x *Lobby) Reset() {
@@ -956,6 +2224,20 @@ func (x *Lobby) GetPlayers() []*Player {
 	return nil
 }
 
+func (x *Lobby) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *Lobby) GetMode() LobbyMode {
+	if x != nil {
+		return x.Mode
+	}
+	return LobbyMode_STANDARD
+}
+
 type Player struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2121,6 +3403,8 @@ type CreateGameRequest struct {
 
 	Player1Id string `protobuf:"bytes,1,opt,name=player1_id,json=player1Id,proto3" json:"player1_id,omitempty"`
 	Player2Id string `protobuf:"bytes,2,opt,name=player2_id,json=player2Id,proto3" json:"player2_id,omitempty"`
+	// 3, 5 or 7 plays a series; 0 or 1 plays a single game.
+	BestOf int32 `protobuf:"varint,3,opt,name=best_of,json=bestOf,proto3" json:"best_of,omitempty"`
 }
 
 func (x *CreateGameRequest) Reset() {
@@ -2169,6 +3453,13 @@ func (x *CreateGameRequest) GetPlayer2Id() string {
 	return ""
 }
 
+func (x *CreateGameRequest) GetBestOf() int32 {
+	if x != nil {
+		return x.BestOf
+	}
+	return 0
+}
+
 type CreateGameReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2658,6 +3949,61 @@ func (*RematchPending) Descriptor() ([]byte, []int) {
 	return file_server2_tctxto2_proto_rawDescGZIP(), []int{40}
 }
 
+type RematchCountdownUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpiresAt        int64 `protobuf:"varint,1,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	SecondsRemaining int32 `protobuf:"varint,2,opt,name=seconds_remaining,json=secondsRemaining,proto3" json:"seconds_remaining,omitempty"`
+}
+
+func (x *RematchCountdownUpdate) Reset() {
+	*x = RematchCountdownUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RematchCountdownUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RematchCountdownUpdate) ProtoMessage() {}
+
+func (x *RematchCountdownUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RematchCountdownUpdate.ProtoReflect.Descriptor instead.
+func (*RematchCountdownUpdate) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RematchCountdownUpdate) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *RematchCountdownUpdate) GetSecondsRemaining() int32 {
+	if x != nil {
+		return x.SecondsRemaining
+	}
+	return 0
+}
+
 type ChangePlayerDisplayNameRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2669,7 +4015,7 @@ type ChangePlayerDisplayNameRequest struct {
 func (x *ChangePlayerDisplayNameRequest) Reset() {
 	*x = ChangePlayerDisplayNameRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server2_tctxto2_proto_msgTypes[41]
+		mi := &file_server2_tctxto2_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2682,7 +4028,7 @@ func (x *ChangePlayerDisplayNameRequest) String() string {
 func (*ChangePlayerDisplayNameRequest) ProtoMessage() {}
 
 func (x *ChangePlayerDisplayNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server2_tctxto2_proto_msgTypes[41]
+	mi := &file_server2_tctxto2_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2695,7 +4041,7 @@ func (x *ChangePlayerDisplayNameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangePlayerDisplayNameRequest.ProtoReflect.Descriptor instead.
 func (*ChangePlayerDisplayNameRequest) Descriptor() ([]byte, []int) {
-	return file_server2_tctxto2_proto_rawDescGZIP(), []int{41}
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *ChangePlayerDisplayNameRequest) GetDisplayName() string {
@@ -2716,7 +4062,7 @@ type ChangePlayerDisplayNameReply struct {
 func (x *ChangePlayerDisplayNameReply) Reset() {
 	*x = ChangePlayerDisplayNameReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server2_tctxto2_proto_msgTypes[42]
+		mi := &file_server2_tctxto2_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2729,7 +4075,7 @@ func (x *ChangePlayerDisplayNameReply) String() string {
 func (*ChangePlayerDisplayNameReply) ProtoMessage() {}
 
 func (x *ChangePlayerDisplayNameReply) ProtoReflect() protoreflect.Message {
-	mi := &file_server2_tctxto2_proto_msgTypes[42]
+	mi := &file_server2_tctxto2_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2742,7 +4088,7 @@ func (x *ChangePlayerDisplayNameReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangePlayerDisplayNameReply.ProtoReflect.Descriptor instead.
 func (*ChangePlayerDisplayNameReply) Descriptor() ([]byte, []int) {
-	return file_server2_tctxto2_proto_rawDescGZIP(), []int{42}
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *ChangePlayerDisplayNameReply) GetOutcome() *Outcome {
@@ -2763,7 +4109,7 @@ type LobbySearchRequest struct {
 func (x *LobbySearchRequest) Reset() {
 	*x = LobbySearchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server2_tctxto2_proto_msgTypes[43]
+		mi := &file_server2_tctxto2_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2776,7 +4122,7 @@ func (x *LobbySearchRequest) String() string {
 func (*LobbySearchRequest) ProtoMessage() {}
 
 func (x *LobbySearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server2_tctxto2_proto_msgTypes[43]
+	mi := &file_server2_tctxto2_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2789,7 +4135,7 @@ func (x *LobbySearchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LobbySearchRequest.ProtoReflect.Descriptor instead.
 func (*LobbySearchRequest) Descriptor() ([]byte, []int) {
-	return file_server2_tctxto2_proto_rawDescGZIP(), []int{43}
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *LobbySearchRequest) GetName() string {
@@ -2810,7 +4156,7 @@ type LobbySearchReply struct {
 func (x *LobbySearchReply) Reset() {
 	*x = LobbySearchReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server2_tctxto2_proto_msgTypes[44]
+		mi := &file_server2_tctxto2_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2823,7 +4169,7 @@ func (x *LobbySearchReply) String() string {
 func (*LobbySearchReply) ProtoMessage() {}
 
 func (x *LobbySearchReply) ProtoReflect() protoreflect.Message {
-	mi := &file_server2_tctxto2_proto_msgTypes[44]
+	mi := &file_server2_tctxto2_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2836,7 +4182,7 @@ func (x *LobbySearchReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LobbySearchReply.ProtoReflect.Descriptor instead.
 func (*LobbySearchReply) Descriptor() ([]byte, []int) {
-	return file_server2_tctxto2_proto_rawDescGZIP(), []int{44}
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *LobbySearchReply) GetOutcome() *Outcome {
@@ -2857,7 +4203,7 @@ type LobbySearchResult struct {
 func (x *LobbySearchResult) Reset() {
 	*x = LobbySearchResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server2_tctxto2_proto_msgTypes[45]
+		mi := &file_server2_tctxto2_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2870,533 +4216,8037 @@ func (x *LobbySearchResult) String() string {
 func (*LobbySearchResult) ProtoMessage() {}
 
 func (x *LobbySearchResult) ProtoReflect() protoreflect.Message {
-	mi := &file_server2_tctxto2_proto_msgTypes[45]
+	mi := &file_server2_tctxto2_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbySearchResult.ProtoReflect.Descriptor instead.
+func (*LobbySearchResult) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *LobbySearchResult) GetLobbies() []*Lobby {
+	if x != nil {
+		return x.Lobbies
+	}
+	return nil
+}
+
+type InviteToLobbyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerName string `protobuf:"bytes,1,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+}
+
+func (x *InviteToLobbyRequest) Reset() {
+	*x = InviteToLobbyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteToLobbyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteToLobbyRequest) ProtoMessage() {}
+
+func (x *InviteToLobbyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteToLobbyRequest.ProtoReflect.Descriptor instead.
+func (*InviteToLobbyRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *InviteToLobbyRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+type InviteToLobbyReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcome *Outcome `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+}
+
+func (x *InviteToLobbyReply) Reset() {
+	*x = InviteToLobbyReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteToLobbyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteToLobbyReply) ProtoMessage() {}
+
+func (x *InviteToLobbyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteToLobbyReply.ProtoReflect.Descriptor instead.
+func (*InviteToLobbyReply) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *InviteToLobbyReply) GetOutcome() *Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+type LobbyInvitation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Lobby     *Lobby  `protobuf:"bytes,2,opt,name=lobby,proto3" json:"lobby,omitempty"`
+	Inviter   *Player `protobuf:"bytes,3,opt,name=inviter,proto3" json:"inviter,omitempty"`
+	ExpiresAt int64   `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *LobbyInvitation) Reset() {
+	*x = LobbyInvitation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LobbyInvitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbyInvitation) ProtoMessage() {}
+
+func (x *LobbyInvitation) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use LobbySearchResult.ProtoReflect.Descriptor instead.
-func (*LobbySearchResult) Descriptor() ([]byte, []int) {
-	return file_server2_tctxto2_proto_rawDescGZIP(), []int{45}
-}
-
-func (x *LobbySearchResult) GetLobbies() []*Lobby {
-	if x != nil {
-		return x.Lobbies
-	}
-	return nil
-}
-
-var File_server2_tctxto2_proto protoreflect.FileDescriptor
-
-var file_server2_tctxto2_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2f, 0x74, 0x63, 0x74, 0x78, 0x74, 0x6f,
-	0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32,
-	0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xf2, 0x06, 0x0a, 0x0c, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x0f, 0x73, 0x69,
-	0x67, 0x6e, 0x5f, 0x75, 0x70, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x53, 0x69,
-	0x67, 0x6e, 0x55, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x73,
-	0x69, 0x67, 0x6e, 0x55, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x0f,
-	0x73, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e,
-	0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
-	0x0d, 0x73, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43,
-	0x0a, 0x10, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x32, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x6f,
-	0x62, 0x62, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
-	0x52, 0x12, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x12, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x6c, 0x6f, 0x62,
-	0x62, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x4c,
-	0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x10, 0x6a,
-	0x6f, 0x69, 0x6e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x53, 0x0a, 0x16, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x5f, 0x6d, 0x79, 0x5f, 0x6c, 0x6f, 0x62, 0x62,
-	0x79, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x4d,
-	0x79, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
-	0x13, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x79, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x67,
-	0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
-	0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x46, 0x0a, 0x11, 0x6d, 0x61, 0x6b, 0x65, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x5f,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x6d, 0x61, 0x6b, 0x65, 0x4d,
-	0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x0f, 0x72, 0x65,
-	0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x52, 0x65,
-	0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0e,
-	0x72, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x75,
-	0x0a, 0x22, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f,
-	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x32, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x1e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x14, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4c, 0x6f,
-	0x62, 0x62, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x48, 0x00, 0x52, 0x12, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xf5,
-	0x0f, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
-	0x23, 0x0a, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x04,
-	0x70, 0x69, 0x6e, 0x67, 0x12, 0x5b, 0x0a, 0x18, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x61,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32,
-	0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x16, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x12, 0x48, 0x0a, 0x11, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6e, 0x61, 0x76, 0x69, 0x67,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x73,
-	0x69, 0x67, 0x6e, 0x5f, 0x75, 0x70, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x53, 0x69, 0x67,
-	0x6e, 0x55, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x69, 0x67, 0x6e,
-	0x55, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3a, 0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x5f,
-	0x69, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x12, 0x3d, 0x0a, 0x0e, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x5f,
-	0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x43, 0x0a, 0x10, 0x6d, 0x79, 0x5f, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x64,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4d, 0x79, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x0e, 0x6d, 0x79, 0x4c, 0x6f, 0x62, 0x62, 0x79,
-	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x53, 0x0a, 0x16, 0x6d, 0x79, 0x5f, 0x6c, 0x6f,
-	0x62, 0x62, 0x79, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x32, 0x2e, 0x4d, 0x79, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x72, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x13, 0x6d, 0x79, 0x4c, 0x6f, 0x62, 0x62, 0x79,
-	0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x53, 0x0a, 0x16,
-	0x6d, 0x79, 0x5f, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x72, 0x5f,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4d, 0x79, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4c, 0x65,
-	0x61, 0x76, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x13, 0x6d, 0x79,
-	0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x12, 0x49, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x6f, 0x62, 0x62,
-	0x79, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f,
-	0x62, 0x62, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x10, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x43, 0x0a, 0x10,
-	0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32,
-	0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48,
-	0x00, 0x52, 0x0e, 0x6a, 0x6f, 0x69, 0x6e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x4d, 0x0a, 0x14, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x5f, 0x6d, 0x79, 0x5f, 0x6c, 0x6f,
-	0x62, 0x62, 0x79, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x4d,
-	0x79, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x11, 0x6c,
-	0x65, 0x61, 0x76, 0x65, 0x4d, 0x79, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x46, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x5f,
-	0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47,
-	0x61, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x40, 0x0a, 0x0f, 0x6d, 0x61, 0x6b, 0x65,
-	0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4d, 0x61, 0x6b, 0x65,
-	0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x0d, 0x6d, 0x61, 0x6b,
-	0x65, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x36, 0x0a, 0x0b, 0x6d, 0x6f,
-	0x76, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x32, 0x2e, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x48, 0x00, 0x52, 0x0c, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x12, 0x36, 0x0a, 0x0b, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18,
-	0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e,
-	0x44, 0x72, 0x61, 0x77, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x72,
-	0x61, 0x77, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x67, 0x61, 0x6d, 0x65,
-	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x12, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x47, 0x61,
-	0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52,
-	0x0f, 0x67, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x12, 0x46, 0x0a, 0x11, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x72, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x4d, 0x6f, 0x76,
-	0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x4f, 0x0a, 0x14, 0x70, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32,
-	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x12, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x5f, 0x0a, 0x1a, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x69,
-	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48,
-	0x00, 0x52, 0x17, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
-	0x4e, 0x61, 0x6d, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x72, 0x65,
-	0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x16, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x52, 0x65, 0x6d, 0x61,
-	0x74, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x61,
-	0x74, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3f, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x61,
-	0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x52, 0x65, 0x6d, 0x61, 0x74,
-	0x63, 0x68, 0x44, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x61,
-	0x74, 0x63, 0x68, 0x44, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x12, 0x45, 0x0a, 0x10, 0x72, 0x65, 0x6d,
-	0x61, 0x74, 0x63, 0x68, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x18, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x52, 0x65,
-	0x6d, 0x61, 0x74, 0x63, 0x68, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x48, 0x00, 0x52,
-	0x0f, 0x72, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64,
-	0x12, 0x42, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x70, 0x65, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x32, 0x2e, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x50, 0x65, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x50, 0x65, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x12, 0x6f, 0x0a, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x1c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x49, 0x0a, 0x12, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x1b, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4c, 0x6f, 0x62, 0x62,
-	0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x10,
-	0x6c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x4c, 0x0a, 0x13, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x11, 0x6c, 0x6f, 0x62,
-	0x62, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x06,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x06, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x22, 0x56,
-	0x0a, 0x05, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x70,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0x2c, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x35, 0x0a, 0x16, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1b,
-	0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x3f, 0x0a, 0x10, 0x4e,
-	0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
-	0x2b, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x37, 0x0a, 0x0d,
-	0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x70, 0x61, 0x73, 0x73, 0x22, 0x39, 0x0a, 0x0b, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e,
-	0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
-	0x22, 0x37, 0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x73, 0x73, 0x22, 0x39, 0x0a, 0x0b, 0x53, 0x69, 0x67,
-	0x6e, 0x55, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63,
-	0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74,
-	0x63, 0x6f, 0x6d, 0x65, 0x22, 0x10, 0x0a, 0x0e, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x0a, 0x0c, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x75,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f,
-	0x6d, 0x65, 0x22, 0x5d, 0x0a, 0x07, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x0e, 0x0a,
-	0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x1d, 0x0a,
-	0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0x36, 0x0a, 0x0e, 0x4d, 0x79, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x44, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4c, 0x6f, 0x62,
-	0x62, 0x79, 0x52, 0x05, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x22, 0x3e, 0x0a, 0x13, 0x4d, 0x79, 0x4c,
-	0x6f, 0x62, 0x62, 0x79, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x12, 0x27, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22, 0x3e, 0x0a, 0x13, 0x4d, 0x79, 0x4c,
-	0x6f, 0x62, 0x62, 0x79, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x12, 0x27, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x65, 0x61,
-	0x76, 0x65, 0x4d, 0x79, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x3f, 0x0a, 0x11, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x79, 0x4c, 0x6f, 0x62, 0x62, 0x79,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32,
-	0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
-	0x65, 0x22, 0x2d, 0x0a, 0x10, 0x4a, 0x6f, 0x69, 0x6e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x64,
-	0x22, 0x3c, 0x0a, 0x0e, 0x4a, 0x6f, 0x69, 0x6e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4f, 0x75,
-	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x3e,
-	0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4f, 0x75,
-	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x48,
-	0x0a, 0x04, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e,
-	0x4d, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2f, 0x0a, 0x0a, 0x4d, 0x6f, 0x76, 0x65,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4d,
-	0x6f, 0x76, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x22, 0x23, 0x0a, 0x0f, 0x4e, 0x65, 0x78,
-	0x74, 0x4d, 0x6f, 0x76, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x79, 0x6f, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x79, 0x6f, 0x75, 0x22, 0x2d,
-	0x0a, 0x0f, 0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3b, 0x0a,
-	0x0d, 0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a,
-	0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
-	0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x28, 0x0a, 0x12, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x51, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61,
-	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x31, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x31, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x32, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x32, 0x49, 0x64, 0x22, 0x3d, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75,
-	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f,
-	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x5b, 0x0a, 0x0c, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x79, 0x6f, 0x75, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x03, 0x79, 0x6f, 0x75, 0x12, 0x39, 0x0a, 0x0c, 0x74, 0x65, 0x63, 0x68,
-	0x6e, 0x69, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63,
-	0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x61, 0x6c,
-	0x69, 0x74, 0x79, 0x22, 0x0c, 0x0a, 0x0a, 0x44, 0x72, 0x61, 0x77, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x22, 0x33, 0x0a, 0x0f, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x03, 0x79, 0x6f, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4d, 0x6f, 0x76, 0x65,
-	0x72, 0x52, 0x03, 0x79, 0x6f, 0x75, 0x22, 0x2e, 0x0a, 0x12, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3b, 0x0a, 0x17, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e,
-	0x61, 0x6d, 0x65, 0x22, 0x22, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x79, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x03, 0x79, 0x65, 0x73, 0x22, 0x3a, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x61, 0x74,
-	0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63,
-	0x6f, 0x6d, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65,
-	0x6e, 0x69, 0x65, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x41,
-	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x22, 0x10, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x61, 0x74,
-	0x63, 0x68, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x42, 0x0a, 0x1e, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
-	0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64,
-	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4a, 0x0a,
-	0x1c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x69, 0x73,
-	0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a,
-	0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
-	0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x28, 0x0a, 0x12, 0x4c, 0x6f, 0x62,
-	0x62, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x3e, 0x0a, 0x10, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63,
-	0x6f, 0x6d, 0x65, 0x22, 0x3d, 0x0a, 0x11, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x28, 0x0a, 0x07, 0x6c, 0x6f, 0x62, 0x62,
-	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x32, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x69,
-	0x65, 0x73, 0x2a, 0x4c, 0x0a, 0x0e, 0x4e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x50, 0x61, 0x74, 0x68, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x45, 0x4c, 0x43, 0x4f, 0x4d, 0x45, 0x10,
-	0x00, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x4f, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4d,
-	0x59, 0x5f, 0x4c, 0x4f, 0x42, 0x42, 0x59, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x47, 0x41, 0x4d,
-	0x45, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x04,
-	0x2a, 0x15, 0x0a, 0x05, 0x4d, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x05, 0x0a, 0x01, 0x58, 0x10, 0x00,
-	0x12, 0x05, 0x0a, 0x01, 0x4f, 0x10, 0x01, 0x2a, 0x2e, 0x0a, 0x0c, 0x54, 0x65, 0x63, 0x68, 0x6e,
-	0x69, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x4f, 0x5f, 0x50, 0x52,
-	0x4f, 0x42, 0x4c, 0x45, 0x4d, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x59, 0x5f, 0x46, 0x4f,
-	0x52, 0x46, 0x45, 0x49, 0x54, 0x10, 0x01, 0x32, 0xbc, 0x01, 0x0a, 0x09, 0x54, 0x69, 0x63, 0x54,
-	0x61, 0x63, 0x54, 0x6f, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x12, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x31, 0x0a,
-	0x06, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x32, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x1a, 0x0e,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x44, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x69, 0x44,
-	0x69, 0x72, 0x12, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x32, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x42, 0x10, 0x5a, 0x0e, 0x74, 0x63, 0x74, 0x78, 0x74, 0x6f,
-	0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_server2_tctxto2_proto_rawDescOnce sync.Once
-	file_server2_tctxto2_proto_rawDescData = file_server2_tctxto2_proto_rawDesc
-)
-
-func file_server2_tctxto2_proto_rawDescGZIP() []byte {
-	file_server2_tctxto2_proto_rawDescOnce.Do(func() {
-		file_server2_tctxto2_proto_rawDescData = protoimpl.X.CompressGZIP(file_server2_tctxto2_proto_rawDescData)
-	})
-	return file_server2_tctxto2_proto_rawDescData
-}
-
-var file_server2_tctxto2_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_server2_tctxto2_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
-var file_server2_tctxto2_proto_goTypes = []interface{}{
-	(NavigationPath)(0),                    // 0: server2.NavigationPath
-	(Mover)(0),                             // 1: server2.Mover
-	(Technicality)(0),                      // 2: server2.Technicality
-	(*Empty)(nil),                          // 3: server2.Empty
-	(*ClientUpdate)(nil),                   // 4: server2.ClientUpdate
-	(*ServerUpdate)(nil),                   // 5: server2.ServerUpdate
-	(*Ping)(nil),                           // 6: server2.Ping
-	(*Lobby)(nil),                          // 7: server2.Lobby
-	(*Player)(nil),                         // 8: server2.Player
-	(*ClientAssignmentUpdate)(nil),         // 9: server2.ClientAssignmentUpdate
-	(*NavigationUpdate)(nil),               // 10: server2.NavigationUpdate
-	(*SignInRequest)(nil),                  // 11: server2.SignInRequest
-	(*SignInReply)(nil),                    // 12: server2.SignInReply
-	(*SignUpRequest)(nil),                  // 13: server2.SignUpRequest
-	(*SignUpReply)(nil),                    // 14: server2.SignUpReply
-	(*SignOutRequest)(nil),                 // 15: server2.SignOutRequest
-	(*SignOutReply)(nil),                   // 16: server2.SignOutReply
-	(*Outcome)(nil),                        // 17: server2.Outcome
-	(*MyLobbyDetails)(nil),                 // 18: server2.MyLobbyDetails
-	(*MyLobbyJoinerUpdate)(nil),            // 19: server2.MyLobbyJoinerUpdate
-	(*MyLobbyLeaverUpdate)(nil),            // 20: server2.MyLobbyLeaverUpdate
-	(*LeaveMyLobbyRequest)(nil),            // 21: server2.LeaveMyLobbyRequest
-	(*LeaveMyLobbyReply)(nil),              // 22: server2.LeaveMyLobbyReply
-	(*JoinLobbyRequest)(nil),               // 23: server2.JoinLobbyRequest
-	(*JoinLobbyReply)(nil),                 // 24: server2.JoinLobbyReply
-	(*CreateLobbyReply)(nil),               // 25: server2.CreateLobbyReply
-	(*Move)(nil),                           // 26: server2.Move
-	(*MoveUpdate)(nil),                     // 27: server2.MoveUpdate
-	(*NextMoverUpdate)(nil),                // 28: server2.NextMoverUpdate
-	(*MakeMoveRequest)(nil),                // 29: server2.MakeMoveRequest
-	(*MakeMoveReply)(nil),                  // 30: server2.MakeMoveReply
-	(*CreateLobbyRequest)(nil),             // 31: server2.CreateLobbyRequest
-	(*CreateGameRequest)(nil),              // 32: server2.CreateGameRequest
-	(*CreateGameReply)(nil),                // 33: server2.CreateGameReply
-	(*WinnerUpdate)(nil),                   // 34: server2.WinnerUpdate
-	(*DrawUpdate)(nil),                     // 35: server2.DrawUpdate
-	(*GameStartUpdate)(nil),                // 36: server2.GameStartUpdate
-	(*PlayerClientUpdate)(nil),             // 37: server2.PlayerClientUpdate
-	(*PlayerDisplayNameUpdate)(nil),        // 38: server2.PlayerDisplayNameUpdate
-	(*RematchRequest)(nil),                 // 39: server2.RematchRequest
-	(*RematchReply)(nil),                   // 40: server2.RematchReply
-	(*RematchDenied)(nil),                  // 41: server2.RematchDenied
-	(*RematchApproved)(nil),                // 42: server2.RematchApproved
-	(*RematchPending)(nil),                 // 43: server2.RematchPending
-	(*ChangePlayerDisplayNameRequest)(nil), // 44: server2.ChangePlayerDisplayNameRequest
-	(*ChangePlayerDisplayNameReply)(nil),   // 45: server2.ChangePlayerDisplayNameReply
-	(*LobbySearchRequest)(nil),             // 46: server2.LobbySearchRequest
-	(*LobbySearchReply)(nil),               // 47: server2.LobbySearchReply
-	(*LobbySearchResult)(nil),              // 48: server2.LobbySearchResult
-}
-var file_server2_tctxto2_proto_depIdxs = []int32{
-	13, // 0: server2.ClientUpdate.sign_up_request:type_name -> server2.SignUpRequest
-	11, // 1: server2.ClientUpdate.sign_in_request:type_name -> server2.SignInRequest
-	15, // 2: server2.ClientUpdate.sign_out_request:type_name -> server2.SignOutRequest
-	31, // 3: server2.ClientUpdate.create_lobby_request:type_name -> server2.CreateLobbyRequest
-	23, // 4: server2.ClientUpdate.join_lobby_request:type_name -> server2.JoinLobbyRequest
-	21, // 5: server2.ClientUpdate.leave_my_lobby_request:type_name -> server2.LeaveMyLobbyRequest
-	32, // 6: server2.ClientUpdate.create_game_request:type_name -> server2.CreateGameRequest
-	29, // 7: server2.ClientUpdate.make_move_request:type_name -> server2.MakeMoveRequest
-	39, // 8: server2.ClientUpdate.rematch_request:type_name -> server2.RematchRequest
-	44, // 9: server2.ClientUpdate.change_player_display_name_request:type_name -> server2.ChangePlayerDisplayNameRequest
-	46, // 10: server2.ClientUpdate.lobby_search_request:type_name -> server2.LobbySearchRequest
-	6,  // 11: server2.ServerUpdate.ping:type_name -> server2.Ping
-	9,  // 12: server2.ServerUpdate.client_assignment_update:type_name -> server2.ClientAssignmentUpdate
-	10, // 13: server2.ServerUpdate.navigation_update:type_name -> server2.NavigationUpdate
-	14, // 14: server2.ServerUpdate.sign_up_reply:type_name -> server2.SignUpReply
-	12, // 15: server2.ServerUpdate.sign_in_reply:type_name -> server2.SignInReply
-	16, // 16: server2.ServerUpdate.sign_out_reply:type_name -> server2.SignOutReply
-	18, // 17: server2.ServerUpdate.my_lobby_details:type_name -> server2.MyLobbyDetails
-	19, // 18: server2.ServerUpdate.my_lobby_joiner_update:type_name -> server2.MyLobbyJoinerUpdate
-	20, // 19: server2.ServerUpdate.my_lobby_leaver_update:type_name -> server2.MyLobbyLeaverUpdate
-	25, // 20: server2.ServerUpdate.create_lobby_reply:type_name -> server2.CreateLobbyReply
-	24, // 21: server2.ServerUpdate.join_lobby_reply:type_name -> server2.JoinLobbyReply
-	22, // 22: server2.ServerUpdate.leave_my_lobby_reply:type_name -> server2.LeaveMyLobbyReply
-	33, // 23: server2.ServerUpdate.create_game_reply:type_name -> server2.CreateGameReply
-	30, // 24: server2.ServerUpdate.make_move_reply:type_name -> server2.MakeMoveReply
-	27, // 25: server2.ServerUpdate.move_update:type_name -> server2.MoveUpdate
-	34, // 26: server2.ServerUpdate.winner_update:type_name -> server2.WinnerUpdate
-	35, // 27: server2.ServerUpdate.draw_update:type_name -> server2.DrawUpdate
-	36, // 28: server2.ServerUpdate.game_start_update:type_name -> server2.GameStartUpdate
-	28, // 29: server2.ServerUpdate.next_mover_update:type_name -> server2.NextMoverUpdate
-	37, // 30: server2.ServerUpdate.player_client_update:type_name -> server2.PlayerClientUpdate
-	38, // 31: server2.ServerUpdate.player_display_name_update:type_name -> server2.PlayerDisplayNameUpdate
-	40, // 32: server2.ServerUpdate.rematch_reply:type_name -> server2.RematchReply
-	41, // 33: server2.ServerUpdate.rematch_denied:type_name -> server2.RematchDenied
-	42, // 34: server2.ServerUpdate.rematch_approved:type_name -> server2.RematchApproved
-	43, // 35: server2.ServerUpdate.rematch_pending:type_name -> server2.RematchPending
-	45, // 36: server2.ServerUpdate.change_player_display_name_reply:type_name -> server2.ChangePlayerDisplayNameReply
-	47, // 37: server2.ServerUpdate.lobby_search_reply:type_name -> server2.LobbySearchReply
-	48, // 38: server2.ServerUpdate.lobby_search_result:type_name -> server2.LobbySearchResult
-	8,  // 39: server2.Lobby.players:type_name -> server2.Player
-	0,  // 40: server2.NavigationUpdate.path:type_name -> server2.NavigationPath
-	17, // 41: server2.SignInReply.Outcome:type_name -> server2.Outcome
-	17, // 42: server2.SignUpReply.outcome:type_name -> server2.Outcome
-	17, // 43: server2.SignOutReply.outcome:type_name -> server2.Outcome
-	7,  // 44: server2.MyLobbyDetails.lobby:type_name -> server2.Lobby
-	8,  // 45: server2.MyLobbyJoinerUpdate.player:type_name -> server2.Player
-	8,  // 46: server2.MyLobbyLeaverUpdate.player:type_name -> server2.Player
-	17, // 47: server2.LeaveMyLobbyReply.outcome:type_name -> server2.Outcome
-	17, // 48: server2.JoinLobbyReply.outcome:type_name -> server2.Outcome
-	17, // 49: server2.CreateLobbyReply.outcome:type_name -> server2.Outcome
-	1,  // 50: server2.Move.mover:type_name -> server2.Mover
-	26, // 51: server2.MoveUpdate.move:type_name -> server2.Move
-	17, // 52: server2.MakeMoveReply.outcome:type_name -> server2.Outcome
-	17, // 53: server2.CreateGameReply.outcome:type_name -> server2.Outcome
-	2,  // 54: server2.WinnerUpdate.technicality:type_name -> server2.Technicality
-	1,  // 55: server2.GameStartUpdate.you:type_name -> server2.Mover
-	17, // 56: server2.RematchReply.outcome:type_name -> server2.Outcome
-	17, // 57: server2.ChangePlayerDisplayNameReply.outcome:type_name -> server2.Outcome
-	17, // 58: server2.LobbySearchReply.outcome:type_name -> server2.Outcome
-	7,  // 59: server2.LobbySearchResult.lobbies:type_name -> server2.Lobby
-	3,  // 60: server2.TicTacToe.Subscribe:input_type -> server2.Empty
-	4,  // 61: server2.TicTacToe.Notify:input_type -> server2.ClientUpdate
-	4,  // 62: server2.TicTacToe.SubscribeBiDir:input_type -> server2.ClientUpdate
-	5,  // 63: server2.TicTacToe.Subscribe:output_type -> server2.ServerUpdate
-	3,  // 64: server2.TicTacToe.Notify:output_type -> server2.Empty
-	5,  // 65: server2.TicTacToe.SubscribeBiDir:output_type -> server2.ServerUpdate
-	63, // [63:66] is the sub-list for method output_type
-	60, // [60:63] is the sub-list for method input_type
-	60, // [60:60] is the sub-list for extension type_name
-	60, // [60:60] is the sub-list for extension extendee
-	0,  // [0:60] is the sub-list for field type_name
-}
-
-func init() { file_server2_tctxto2_proto_init() }
-func file_server2_tctxto2_proto_init() {
-	if File_server2_tctxto2_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_server2_tctxto2_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Empty); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbyInvitation.ProtoReflect.Descriptor instead.
+func (*LobbyInvitation) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *LobbyInvitation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LobbyInvitation) GetLobby() *Lobby {
+	if x != nil {
+		return x.Lobby
+	}
+	return nil
+}
+
+func (x *LobbyInvitation) GetInviter() *Player {
+	if x != nil {
+		return x.Inviter
+	}
+	return nil
+}
+
+func (x *LobbyInvitation) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type LobbyInvitationUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invitation *LobbyInvitation `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+}
+
+func (x *LobbyInvitationUpdate) Reset() {
+	*x = LobbyInvitationUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LobbyInvitationUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbyInvitationUpdate) ProtoMessage() {}
+
+func (x *LobbyInvitationUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbyInvitationUpdate.ProtoReflect.Descriptor instead.
+func (*LobbyInvitationUpdate) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *LobbyInvitationUpdate) GetInvitation() *LobbyInvitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+type RespondLobbyInvitationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvitationId string `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	Accept       bool   `protobuf:"varint,2,opt,name=accept,proto3" json:"accept,omitempty"`
+}
+
+func (x *RespondLobbyInvitationRequest) Reset() {
+	*x = RespondLobbyInvitationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondLobbyInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondLobbyInvitationRequest) ProtoMessage() {}
+
+func (x *RespondLobbyInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondLobbyInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RespondLobbyInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RespondLobbyInvitationRequest) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+func (x *RespondLobbyInvitationRequest) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type RespondLobbyInvitationReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcome *Outcome `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+}
+
+func (x *RespondLobbyInvitationReply) Reset() {
+	*x = RespondLobbyInvitationReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondLobbyInvitationReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondLobbyInvitationReply) ProtoMessage() {}
+
+func (x *RespondLobbyInvitationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondLobbyInvitationReply.ProtoReflect.Descriptor instead.
+func (*RespondLobbyInvitationReply) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RespondLobbyInvitationReply) GetOutcome() *Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+type LobbyInvitationResponseUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvitationId string           `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	Invitee      *Player          `protobuf:"bytes,2,opt,name=invitee,proto3" json:"invitee,omitempty"`
+	Status       InvitationStatus `protobuf:"varint,3,opt,name=status,proto3,enum=server2.InvitationStatus" json:"status,omitempty"`
+}
+
+func (x *LobbyInvitationResponseUpdate) Reset() {
+	*x = LobbyInvitationResponseUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LobbyInvitationResponseUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbyInvitationResponseUpdate) ProtoMessage() {}
+
+func (x *LobbyInvitationResponseUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbyInvitationResponseUpdate.ProtoReflect.Descriptor instead.
+func (*LobbyInvitationResponseUpdate) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *LobbyInvitationResponseUpdate) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+func (x *LobbyInvitationResponseUpdate) GetInvitee() *Player {
+	if x != nil {
+		return x.Invitee
+	}
+	return nil
+}
+
+func (x *LobbyInvitationResponseUpdate) GetStatus() InvitationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InvitationStatus_PENDING
+}
+
+type SetReadyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ready bool `protobuf:"varint,1,opt,name=ready,proto3" json:"ready,omitempty"`
+}
+
+func (x *SetReadyRequest) Reset() {
+	*x = SetReadyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetReadyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReadyRequest) ProtoMessage() {}
+
+func (x *SetReadyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReadyRequest.ProtoReflect.Descriptor instead.
+func (*SetReadyRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SetReadyRequest) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+type SetReadyReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcome *Outcome `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+}
+
+func (x *SetReadyReply) Reset() {
+	*x = SetReadyReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetReadyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReadyReply) ProtoMessage() {}
+
+func (x *SetReadyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReadyReply.ProtoReflect.Descriptor instead.
+func (*SetReadyReply) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SetReadyReply) GetOutcome() *Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+type LobbyReadyStateUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReadyPlayerIds []string `protobuf:"bytes,1,rep,name=ready_player_ids,json=readyPlayerIds,proto3" json:"ready_player_ids,omitempty"`
+	GameId         string   `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *LobbyReadyStateUpdate) Reset() {
+	*x = LobbyReadyStateUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LobbyReadyStateUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbyReadyStateUpdate) ProtoMessage() {}
+
+func (x *LobbyReadyStateUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbyReadyStateUpdate.ProtoReflect.Descriptor instead.
+func (*LobbyReadyStateUpdate) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *LobbyReadyStateUpdate) GetReadyPlayerIds() []string {
+	if x != nil {
+		return x.ReadyPlayerIds
+	}
+	return nil
+}
+
+func (x *LobbyReadyStateUpdate) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type SetLobbyModeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode LobbyMode `protobuf:"varint,1,opt,name=mode,proto3,enum=server2.LobbyMode" json:"mode,omitempty"`
+}
+
+func (x *SetLobbyModeRequest) Reset() {
+	*x = SetLobbyModeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLobbyModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLobbyModeRequest) ProtoMessage() {}
+
+func (x *SetLobbyModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLobbyModeRequest.ProtoReflect.Descriptor instead.
+func (*SetLobbyModeRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SetLobbyModeRequest) GetMode() LobbyMode {
+	if x != nil {
+		return x.Mode
+	}
+	return LobbyMode_STANDARD
+}
+
+type SetLobbyModeReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcome *Outcome `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+}
+
+func (x *SetLobbyModeReply) Reset() {
+	*x = SetLobbyModeReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLobbyModeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLobbyModeReply) ProtoMessage() {}
+
+func (x *SetLobbyModeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLobbyModeReply.ProtoReflect.Descriptor instead.
+func (*SetLobbyModeReply) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SetLobbyModeReply) GetOutcome() *Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+type JoinPlayQueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *JoinPlayQueueRequest) Reset() {
+	*x = JoinPlayQueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinPlayQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinPlayQueueRequest) ProtoMessage() {}
+
+func (x *JoinPlayQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinPlayQueueRequest.ProtoReflect.Descriptor instead.
+func (*JoinPlayQueueRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{59}
+}
+
+type JoinPlayQueueReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcome *Outcome `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+}
+
+func (x *JoinPlayQueueReply) Reset() {
+	*x = JoinPlayQueueReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinPlayQueueReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinPlayQueueReply) ProtoMessage() {}
+
+func (x *JoinPlayQueueReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinPlayQueueReply.ProtoReflect.Descriptor instead.
+func (*JoinPlayQueueReply) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *JoinPlayQueueReply) GetOutcome() *Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+type LeavePlayQueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LeavePlayQueueRequest) Reset() {
+	*x = LeavePlayQueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeavePlayQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeavePlayQueueRequest) ProtoMessage() {}
+
+func (x *LeavePlayQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeavePlayQueueRequest.ProtoReflect.Descriptor instead.
+func (*LeavePlayQueueRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{61}
+}
+
+type LeavePlayQueueReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcome *Outcome `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+}
+
+func (x *LeavePlayQueueReply) Reset() {
+	*x = LeavePlayQueueReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeavePlayQueueReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeavePlayQueueReply) ProtoMessage() {}
+
+func (x *LeavePlayQueueReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeavePlayQueueReply.ProtoReflect.Descriptor instead.
+func (*LeavePlayQueueReply) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *LeavePlayQueueReply) GetOutcome() *Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+type PlayQueueUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode             LobbyMode `protobuf:"varint,1,opt,name=mode,proto3,enum=server2.LobbyMode" json:"mode,omitempty"`
+	QueuedPlayerIds  []string  `protobuf:"bytes,2,rep,name=queued_player_ids,json=queuedPlayerIds,proto3" json:"queued_player_ids,omitempty"`
+	PlayingPlayerIds []string  `protobuf:"bytes,3,rep,name=playing_player_ids,json=playingPlayerIds,proto3" json:"playing_player_ids,omitempty"`
+}
+
+func (x *PlayQueueUpdate) Reset() {
+	*x = PlayQueueUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayQueueUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayQueueUpdate) ProtoMessage() {}
+
+func (x *PlayQueueUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayQueueUpdate.ProtoReflect.Descriptor instead.
+func (*PlayQueueUpdate) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *PlayQueueUpdate) GetMode() LobbyMode {
+	if x != nil {
+		return x.Mode
+	}
+	return LobbyMode_STANDARD
+}
+
+func (x *PlayQueueUpdate) GetQueuedPlayerIds() []string {
+	if x != nil {
+		return x.QueuedPlayerIds
+	}
+	return nil
+}
+
+func (x *PlayQueueUpdate) GetPlayingPlayerIds() []string {
+	if x != nil {
+		return x.PlayingPlayerIds
+	}
+	return nil
+}
+
+type CreateTournamentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Format              TournamentFormat `protobuf:"varint,2,opt,name=format,proto3,enum=server2.TournamentFormat" json:"format,omitempty"`
+	RegistrationSeconds int32            `protobuf:"varint,3,opt,name=registration_seconds,json=registrationSeconds,proto3" json:"registration_seconds,omitempty"`
+}
+
+func (x *CreateTournamentRequest) Reset() {
+	*x = CreateTournamentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTournamentRequest) ProtoMessage() {}
+
+func (x *CreateTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTournamentRequest.ProtoReflect.Descriptor instead.
+func (*CreateTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CreateTournamentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTournamentRequest) GetFormat() TournamentFormat {
+	if x != nil {
+		return x.Format
+	}
+	return TournamentFormat_SINGLE_ELIMINATION
+}
+
+func (x *CreateTournamentRequest) GetRegistrationSeconds() int32 {
+	if x != nil {
+		return x.RegistrationSeconds
+	}
+	return 0
+}
+
+type CreateTournamentReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcome *Outcome `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+}
+
+func (x *CreateTournamentReply) Reset() {
+	*x = CreateTournamentReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTournamentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTournamentReply) ProtoMessage() {}
+
+func (x *CreateTournamentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTournamentReply.ProtoReflect.Descriptor instead.
+func (*CreateTournamentReply) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CreateTournamentReply) GetOutcome() *Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+type JoinTournamentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TournamentId string `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+}
+
+func (x *JoinTournamentRequest) Reset() {
+	*x = JoinTournamentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinTournamentRequest) ProtoMessage() {}
+
+func (x *JoinTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinTournamentRequest.ProtoReflect.Descriptor instead.
+func (*JoinTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *JoinTournamentRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+type JoinTournamentReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcome *Outcome `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+}
+
+func (x *JoinTournamentReply) Reset() {
+	*x = JoinTournamentReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinTournamentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinTournamentReply) ProtoMessage() {}
+
+func (x *JoinTournamentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinTournamentReply.ProtoReflect.Descriptor instead.
+func (*JoinTournamentReply) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *JoinTournamentReply) GetOutcome() *Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+type LeaveTournamentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TournamentId string `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+}
+
+func (x *LeaveTournamentRequest) Reset() {
+	*x = LeaveTournamentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveTournamentRequest) ProtoMessage() {}
+
+func (x *LeaveTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveTournamentRequest.ProtoReflect.Descriptor instead.
+func (*LeaveTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *LeaveTournamentRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+type LeaveTournamentReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcome *Outcome `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+}
+
+func (x *LeaveTournamentReply) Reset() {
+	*x = LeaveTournamentReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveTournamentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveTournamentReply) ProtoMessage() {}
+
+func (x *LeaveTournamentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveTournamentReply.ProtoReflect.Descriptor instead.
+func (*LeaveTournamentReply) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *LeaveTournamentReply) GetOutcome() *Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+type WatchTournamentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TournamentId string `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Watch        bool   `protobuf:"varint,2,opt,name=watch,proto3" json:"watch,omitempty"`
+}
+
+func (x *WatchTournamentRequest) Reset() {
+	*x = WatchTournamentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTournamentRequest) ProtoMessage() {}
+
+func (x *WatchTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTournamentRequest.ProtoReflect.Descriptor instead.
+func (*WatchTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *WatchTournamentRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *WatchTournamentRequest) GetWatch() bool {
+	if x != nil {
+		return x.Watch
+	}
+	return false
+}
+
+type WatchTournamentReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcome *Outcome `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+}
+
+func (x *WatchTournamentReply) Reset() {
+	*x = WatchTournamentReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchTournamentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTournamentReply) ProtoMessage() {}
+
+func (x *WatchTournamentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTournamentReply.ProtoReflect.Descriptor instead.
+func (*WatchTournamentReply) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *WatchTournamentReply) GetOutcome() *Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+type GetTournamentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TournamentId string `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+}
+
+func (x *GetTournamentRequest) Reset() {
+	*x = GetTournamentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTournamentRequest) ProtoMessage() {}
+
+func (x *GetTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTournamentRequest.ProtoReflect.Descriptor instead.
+func (*GetTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetTournamentRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+type GetTournamentReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcome *Outcome `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+}
+
+func (x *GetTournamentReply) Reset() {
+	*x = GetTournamentReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTournamentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTournamentReply) ProtoMessage() {}
+
+func (x *GetTournamentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTournamentReply.ProtoReflect.Descriptor instead.
+func (*GetTournamentReply) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetTournamentReply) GetOutcome() *Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+type Tournament struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Format             TournamentFormat      `protobuf:"varint,3,opt,name=format,proto3,enum=server2.TournamentFormat" json:"format,omitempty"`
+	Status             TournamentStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=server2.TournamentStatus" json:"status,omitempty"`
+	RegistrationEndsAt int64                 `protobuf:"varint,5,opt,name=registration_ends_at,json=registrationEndsAt,proto3" json:"registration_ends_at,omitempty"`
+	Entrants           []*Player             `protobuf:"bytes,6,rep,name=entrants,proto3" json:"entrants,omitempty"`
+	Round              int32                 `protobuf:"varint,7,opt,name=round,proto3" json:"round,omitempty"`
+	Matches            []*TournamentMatch    `protobuf:"bytes,8,rep,name=matches,proto3" json:"matches,omitempty"`
+	Standings          []*TournamentStanding `protobuf:"bytes,9,rep,name=standings,proto3" json:"standings,omitempty"`
+}
+
+func (x *Tournament) Reset() {
+	*x = Tournament{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tournament) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tournament) ProtoMessage() {}
+
+func (x *Tournament) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tournament.ProtoReflect.Descriptor instead.
+func (*Tournament) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *Tournament) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Tournament) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tournament) GetFormat() TournamentFormat {
+	if x != nil {
+		return x.Format
+	}
+	return TournamentFormat_SINGLE_ELIMINATION
+}
+
+func (x *Tournament) GetStatus() TournamentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TournamentStatus_REGISTRATION
+}
+
+func (x *Tournament) GetRegistrationEndsAt() int64 {
+	if x != nil {
+		return x.RegistrationEndsAt
+	}
+	return 0
+}
+
+func (x *Tournament) GetEntrants() []*Player {
+	if x != nil {
+		return x.Entrants
+	}
+	return nil
+}
+
+func (x *Tournament) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *Tournament) GetMatches() []*TournamentMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *Tournament) GetStandings() []*TournamentStanding {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+type TournamentMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Round    int32   `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Player1  *Player `protobuf:"bytes,3,opt,name=player1,proto3" json:"player1,omitempty"`
+	Player2  *Player `protobuf:"bytes,4,opt,name=player2,proto3" json:"player2,omitempty"`
+	GameId   string  `protobuf:"bytes,5,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	WinnerId string  `protobuf:"bytes,6,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
+	Finished bool    `protobuf:"varint,7,opt,name=finished,proto3" json:"finished,omitempty"`
+}
+
+func (x *TournamentMatch) Reset() {
+	*x = TournamentMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TournamentMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentMatch) ProtoMessage() {}
+
+func (x *TournamentMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentMatch.ProtoReflect.Descriptor instead.
+func (*TournamentMatch) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *TournamentMatch) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TournamentMatch) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *TournamentMatch) GetPlayer1() *Player {
+	if x != nil {
+		return x.Player1
+	}
+	return nil
+}
+
+func (x *TournamentMatch) GetPlayer2() *Player {
+	if x != nil {
+		return x.Player2
+	}
+	return nil
+}
+
+func (x *TournamentMatch) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *TournamentMatch) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+func (x *TournamentMatch) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+type TournamentStanding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank   int32   `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Player *Player `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
+	Wins   int32   `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty"`
+	Draws  int32   `protobuf:"varint,4,opt,name=draws,proto3" json:"draws,omitempty"`
+	Losses int32   `protobuf:"varint,5,opt,name=losses,proto3" json:"losses,omitempty"`
+	Points int32   `protobuf:"varint,6,opt,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *TournamentStanding) Reset() {
+	*x = TournamentStanding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TournamentStanding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentStanding) ProtoMessage() {}
+
+func (x *TournamentStanding) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentStanding.ProtoReflect.Descriptor instead.
+func (*TournamentStanding) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *TournamentStanding) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *TournamentStanding) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *TournamentStanding) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *TournamentStanding) GetDraws() int32 {
+	if x != nil {
+		return x.Draws
+	}
+	return 0
+}
+
+func (x *TournamentStanding) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *TournamentStanding) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+type TournamentBracketUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tournament *Tournament `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
+}
+
+func (x *TournamentBracketUpdate) Reset() {
+	*x = TournamentBracketUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TournamentBracketUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentBracketUpdate) ProtoMessage() {}
+
+func (x *TournamentBracketUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentBracketUpdate.ProtoReflect.Descriptor instead.
+func (*TournamentBracketUpdate) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *TournamentBracketUpdate) GetTournament() *Tournament {
+	if x != nil {
+		return x.Tournament
+	}
+	return nil
+}
+
+type SeriesScoreUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SeriesId     string `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	BestOf       int32  `protobuf:"varint,2,opt,name=best_of,json=bestOf,proto3" json:"best_of,omitempty"`
+	GamesPlayed  int32  `protobuf:"varint,3,opt,name=games_played,json=gamesPlayed,proto3" json:"games_played,omitempty"`
+	YourWins     int32  `protobuf:"varint,4,opt,name=your_wins,json=yourWins,proto3" json:"your_wins,omitempty"`
+	OpponentWins int32  `protobuf:"varint,5,opt,name=opponent_wins,json=opponentWins,proto3" json:"opponent_wins,omitempty"`
+	Draws        int32  `protobuf:"varint,6,opt,name=draws,proto3" json:"draws,omitempty"`
+	Decided      bool   `protobuf:"varint,7,opt,name=decided,proto3" json:"decided,omitempty"`
+	YouWon       bool   `protobuf:"varint,8,opt,name=you_won,json=youWon,proto3" json:"you_won,omitempty"`
+	// Set when the series ended early because its next game could not be
+	// started.
+	Outcome *Outcome `protobuf:"bytes,9,opt,name=outcome,proto3" json:"outcome,omitempty"`
+}
+
+func (x *SeriesScoreUpdate) Reset() {
+	*x = SeriesScoreUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeriesScoreUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesScoreUpdate) ProtoMessage() {}
+
+func (x *SeriesScoreUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeriesScoreUpdate.ProtoReflect.Descriptor instead.
+func (*SeriesScoreUpdate) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SeriesScoreUpdate) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *SeriesScoreUpdate) GetBestOf() int32 {
+	if x != nil {
+		return x.BestOf
+	}
+	return 0
+}
+
+func (x *SeriesScoreUpdate) GetGamesPlayed() int32 {
+	if x != nil {
+		return x.GamesPlayed
+	}
+	return 0
+}
+
+func (x *SeriesScoreUpdate) GetYourWins() int32 {
+	if x != nil {
+		return x.YourWins
+	}
+	return 0
+}
+
+func (x *SeriesScoreUpdate) GetOpponentWins() int32 {
+	if x != nil {
+		return x.OpponentWins
+	}
+	return 0
+}
+
+func (x *SeriesScoreUpdate) GetDraws() int32 {
+	if x != nil {
+		return x.Draws
+	}
+	return 0
+}
+
+func (x *SeriesScoreUpdate) GetDecided() bool {
+	if x != nil {
+		return x.Decided
+	}
+	return false
+}
+
+func (x *SeriesScoreUpdate) GetYouWon() bool {
+	if x != nil {
+		return x.YouWon
+	}
+	return false
+}
+
+func (x *SeriesScoreUpdate) GetOutcome() *Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+type AddFriendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerName string `protobuf:"bytes,1,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+}
+
+func (x *AddFriendRequest) Reset() {
+	*x = AddFriendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddFriendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFriendRequest) ProtoMessage() {}
+
+func (x *AddFriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFriendRequest.ProtoReflect.Descriptor instead.
+func (*AddFriendRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *AddFriendRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+type AddFriendReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcome *Outcome `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+}
+
+func (x *AddFriendReply) Reset() {
+	*x = AddFriendReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddFriendReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFriendReply) ProtoMessage() {}
+
+func (x *AddFriendReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFriendReply.ProtoReflect.Descriptor instead.
+func (*AddFriendReply) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *AddFriendReply) GetOutcome() *Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+type RemoveFriendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+}
+
+func (x *RemoveFriendRequest) Reset() {
+	*x = RemoveFriendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveFriendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFriendRequest) ProtoMessage() {}
+
+func (x *RemoveFriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFriendRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFriendRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *RemoveFriendRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type RemoveFriendReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcome *Outcome `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+}
+
+func (x *RemoveFriendReply) Reset() {
+	*x = RemoveFriendReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveFriendReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFriendReply) ProtoMessage() {}
+
+func (x *RemoveFriendReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFriendReply.ProtoReflect.Descriptor instead.
+func (*RemoveFriendReply) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *RemoveFriendReply) GetOutcome() *Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+type RespondFriendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Accept   bool   `protobuf:"varint,2,opt,name=accept,proto3" json:"accept,omitempty"`
+}
+
+func (x *RespondFriendRequest) Reset() {
+	*x = RespondFriendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondFriendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondFriendRequest) ProtoMessage() {}
+
+func (x *RespondFriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondFriendRequest.ProtoReflect.Descriptor instead.
+func (*RespondFriendRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *RespondFriendRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *RespondFriendRequest) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type RespondFriendReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcome *Outcome `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+}
+
+func (x *RespondFriendReply) Reset() {
+	*x = RespondFriendReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondFriendReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondFriendReply) ProtoMessage() {}
+
+func (x *RespondFriendReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondFriendReply.ProtoReflect.Descriptor instead.
+func (*RespondFriendReply) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *RespondFriendReply) GetOutcome() *Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+type Friend struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player   *Player  `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	Presence Presence `protobuf:"varint,2,opt,name=presence,proto3,enum=server2.Presence" json:"presence,omitempty"`
+}
+
+func (x *Friend) Reset() {
+	*x = Friend{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Friend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Friend) ProtoMessage() {}
+
+func (x *Friend) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Friend.ProtoReflect.Descriptor instead.
+func (*Friend) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *Friend) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *Friend) GetPresence() Presence {
+	if x != nil {
+		return x.Presence
+	}
+	return Presence_OFFLINE
+}
+
+type FriendListUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Friends          []*Friend `protobuf:"bytes,1,rep,name=friends,proto3" json:"friends,omitempty"`
+	IncomingRequests []*Player `protobuf:"bytes,2,rep,name=incoming_requests,json=incomingRequests,proto3" json:"incoming_requests,omitempty"`
+	OutgoingRequests []*Player `protobuf:"bytes,3,rep,name=outgoing_requests,json=outgoingRequests,proto3" json:"outgoing_requests,omitempty"`
+}
+
+func (x *FriendListUpdate) Reset() {
+	*x = FriendListUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FriendListUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendListUpdate) ProtoMessage() {}
+
+func (x *FriendListUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendListUpdate.ProtoReflect.Descriptor instead.
+func (*FriendListUpdate) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *FriendListUpdate) GetFriends() []*Friend {
+	if x != nil {
+		return x.Friends
+	}
+	return nil
+}
+
+func (x *FriendListUpdate) GetIncomingRequests() []*Player {
+	if x != nil {
+		return x.IncomingRequests
+	}
+	return nil
+}
+
+func (x *FriendListUpdate) GetOutgoingRequests() []*Player {
+	if x != nil {
+		return x.OutgoingRequests
+	}
+	return nil
+}
+
+type FriendPresenceUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Friend *Friend `protobuf:"bytes,1,opt,name=friend,proto3" json:"friend,omitempty"`
+}
+
+func (x *FriendPresenceUpdate) Reset() {
+	*x = FriendPresenceUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FriendPresenceUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendPresenceUpdate) ProtoMessage() {}
+
+func (x *FriendPresenceUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendPresenceUpdate.ProtoReflect.Descriptor instead.
+func (*FriendPresenceUpdate) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *FriendPresenceUpdate) GetFriend() *Friend {
+	if x != nil {
+		return x.Friend
+	}
+	return nil
+}
+
+// Games have no move clock, so a challenge has no time control.
+type ChallengePlayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerName string `protobuf:"bytes,1,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	// 3, 5 or 7 plays a series; 0 or 1 plays a single game.
+	BestOf int32 `protobuf:"varint,2,opt,name=best_of,json=bestOf,proto3" json:"best_of,omitempty"`
+}
+
+func (x *ChallengePlayerRequest) Reset() {
+	*x = ChallengePlayerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChallengePlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChallengePlayerRequest) ProtoMessage() {}
+
+func (x *ChallengePlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChallengePlayerRequest.ProtoReflect.Descriptor instead.
+func (*ChallengePlayerRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ChallengePlayerRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *ChallengePlayerRequest) GetBestOf() int32 {
+	if x != nil {
+		return x.BestOf
+	}
+	return 0
+}
+
+type ChallengePlayerReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcome *Outcome `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+}
+
+func (x *ChallengePlayerReply) Reset() {
+	*x = ChallengePlayerReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChallengePlayerReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChallengePlayerReply) ProtoMessage() {}
+
+func (x *ChallengePlayerReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChallengePlayerReply.ProtoReflect.Descriptor instead.
+func (*ChallengePlayerReply) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ChallengePlayerReply) GetOutcome() *Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+type Challenge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Challenger *Player `protobuf:"bytes,2,opt,name=challenger,proto3" json:"challenger,omitempty"`
+	Challengee *Player `protobuf:"bytes,3,opt,name=challengee,proto3" json:"challengee,omitempty"`
+	BestOf     int32   `protobuf:"varint,4,opt,name=best_of,json=bestOf,proto3" json:"best_of,omitempty"`
+	ExpiresAt  int64   `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *Challenge) Reset() {
+	*x = Challenge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Challenge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Challenge) ProtoMessage() {}
+
+func (x *Challenge) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Challenge.ProtoReflect.Descriptor instead.
+func (*Challenge) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *Challenge) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Challenge) GetChallenger() *Player {
+	if x != nil {
+		return x.Challenger
+	}
+	return nil
+}
+
+func (x *Challenge) GetChallengee() *Player {
+	if x != nil {
+		return x.Challengee
+	}
+	return nil
+}
+
+func (x *Challenge) GetBestOf() int32 {
+	if x != nil {
+		return x.BestOf
+	}
+	return 0
+}
+
+func (x *Challenge) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type ChallengeReceivedUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Challenge *Challenge `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+}
+
+func (x *ChallengeReceivedUpdate) Reset() {
+	*x = ChallengeReceivedUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChallengeReceivedUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChallengeReceivedUpdate) ProtoMessage() {}
+
+func (x *ChallengeReceivedUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChallengeReceivedUpdate.ProtoReflect.Descriptor instead.
+func (*ChallengeReceivedUpdate) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ChallengeReceivedUpdate) GetChallenge() *Challenge {
+	if x != nil {
+		return x.Challenge
+	}
+	return nil
+}
+
+type RespondChallengeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChallengeId string `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	Accept      bool   `protobuf:"varint,2,opt,name=accept,proto3" json:"accept,omitempty"`
+}
+
+func (x *RespondChallengeRequest) Reset() {
+	*x = RespondChallengeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondChallengeRequest) ProtoMessage() {}
+
+func (x *RespondChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondChallengeRequest.ProtoReflect.Descriptor instead.
+func (*RespondChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *RespondChallengeRequest) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *RespondChallengeRequest) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type RespondChallengeReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcome *Outcome `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+}
+
+func (x *RespondChallengeReply) Reset() {
+	*x = RespondChallengeReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondChallengeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondChallengeReply) ProtoMessage() {}
+
+func (x *RespondChallengeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondChallengeReply.ProtoReflect.Descriptor instead.
+func (*RespondChallengeReply) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *RespondChallengeReply) GetOutcome() *Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+type CancelChallengeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChallengeId string `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+}
+
+func (x *CancelChallengeRequest) Reset() {
+	*x = CancelChallengeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelChallengeRequest) ProtoMessage() {}
+
+func (x *CancelChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelChallengeRequest.ProtoReflect.Descriptor instead.
+func (*CancelChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *CancelChallengeRequest) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+type CancelChallengeReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcome *Outcome `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+}
+
+func (x *CancelChallengeReply) Reset() {
+	*x = CancelChallengeReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelChallengeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelChallengeReply) ProtoMessage() {}
+
+func (x *CancelChallengeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelChallengeReply.ProtoReflect.Descriptor instead.
+func (*CancelChallengeReply) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *CancelChallengeReply) GetOutcome() *Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+type ChallengeResponseUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Challenge *Challenge       `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	Status    InvitationStatus `protobuf:"varint,2,opt,name=status,proto3,enum=server2.InvitationStatus" json:"status,omitempty"`
+}
+
+func (x *ChallengeResponseUpdate) Reset() {
+	*x = ChallengeResponseUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChallengeResponseUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChallengeResponseUpdate) ProtoMessage() {}
+
+func (x *ChallengeResponseUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChallengeResponseUpdate.ProtoReflect.Descriptor instead.
+func (*ChallengeResponseUpdate) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ChallengeResponseUpdate) GetChallenge() *Challenge {
+	if x != nil {
+		return x.Challenge
+	}
+	return nil
+}
+
+func (x *ChallengeResponseUpdate) GetStatus() InvitationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InvitationStatus_PENDING
+}
+
+type BlockPlayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+}
+
+func (x *BlockPlayerRequest) Reset() {
+	*x = BlockPlayerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockPlayerRequest) ProtoMessage() {}
+
+func (x *BlockPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockPlayerRequest.ProtoReflect.Descriptor instead.
+func (*BlockPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *BlockPlayerRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type BlockPlayerReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcome *Outcome `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+}
+
+func (x *BlockPlayerReply) Reset() {
+	*x = BlockPlayerReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockPlayerReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockPlayerReply) ProtoMessage() {}
+
+func (x *BlockPlayerReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockPlayerReply.ProtoReflect.Descriptor instead.
+func (*BlockPlayerReply) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *BlockPlayerReply) GetOutcome() *Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+type UnblockPlayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+}
+
+func (x *UnblockPlayerRequest) Reset() {
+	*x = UnblockPlayerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnblockPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockPlayerRequest) ProtoMessage() {}
+
+func (x *UnblockPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockPlayerRequest.ProtoReflect.Descriptor instead.
+func (*UnblockPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *UnblockPlayerRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type UnblockPlayerReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcome *Outcome `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+}
+
+func (x *UnblockPlayerReply) Reset() {
+	*x = UnblockPlayerReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnblockPlayerReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockPlayerReply) ProtoMessage() {}
+
+func (x *UnblockPlayerReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockPlayerReply.ProtoReflect.Descriptor instead.
+func (*UnblockPlayerReply) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *UnblockPlayerReply) GetOutcome() *Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+type BlockListUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Players []*Player `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+}
+
+func (x *BlockListUpdate) Reset() {
+	*x = BlockListUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockListUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockListUpdate) ProtoMessage() {}
+
+func (x *BlockListUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockListUpdate.ProtoReflect.Descriptor instead.
+func (*BlockListUpdate) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *BlockListUpdate) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+type ChangePasswordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurrentPass string `protobuf:"bytes,1,opt,name=current_pass,json=currentPass,proto3" json:"current_pass,omitempty"`
+	NewPass     string `protobuf:"bytes,2,opt,name=new_pass,json=newPass,proto3" json:"new_pass,omitempty"`
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ChangePasswordRequest) GetCurrentPass() string {
+	if x != nil {
+		return x.CurrentPass
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPass() string {
+	if x != nil {
+		return x.NewPass
+	}
+	return ""
+}
+
+type ChangePasswordReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcome *Outcome `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+}
+
+func (x *ChangePasswordReply) Reset() {
+	*x = ChangePasswordReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangePasswordReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordReply) ProtoMessage() {}
+
+func (x *ChangePasswordReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordReply.ProtoReflect.Descriptor instead.
+func (*ChangePasswordReply) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ChangePasswordReply) GetOutcome() *Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+type ChangeUsernameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Pass string `protobuf:"bytes,2,opt,name=pass,proto3" json:"pass,omitempty"`
+}
+
+func (x *ChangeUsernameRequest) Reset() {
+	*x = ChangeUsernameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeUsernameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUsernameRequest) ProtoMessage() {}
+
+func (x *ChangeUsernameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUsernameRequest.ProtoReflect.Descriptor instead.
+func (*ChangeUsernameRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ChangeUsernameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChangeUsernameRequest) GetPass() string {
+	if x != nil {
+		return x.Pass
+	}
+	return ""
+}
+
+type ChangeUsernameReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcome *Outcome `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+}
+
+func (x *ChangeUsernameReply) Reset() {
+	*x = ChangeUsernameReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeUsernameReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUsernameReply) ProtoMessage() {}
+
+func (x *ChangeUsernameReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUsernameReply.ProtoReflect.Descriptor instead.
+func (*ChangeUsernameReply) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ChangeUsernameReply) GetOutcome() *Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+type DeleteAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pass string `protobuf:"bytes,1,opt,name=pass,proto3" json:"pass,omitempty"`
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *DeleteAccountRequest) GetPass() string {
+	if x != nil {
+		return x.Pass
+	}
+	return ""
+}
+
+type DeleteAccountReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcome *Outcome `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+}
+
+func (x *DeleteAccountReply) Reset() {
+	*x = DeleteAccountReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAccountReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountReply) ProtoMessage() {}
+
+func (x *DeleteAccountReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountReply.ProtoReflect.Descriptor instead.
+func (*DeleteAccountReply) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *DeleteAccountReply) GetOutcome() *Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+type PlayerRenamedUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player *Player `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+}
+
+func (x *PlayerRenamedUpdate) Reset() {
+	*x = PlayerRenamedUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerRenamedUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerRenamedUpdate) ProtoMessage() {}
+
+func (x *PlayerRenamedUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerRenamedUpdate.ProtoReflect.Descriptor instead.
+func (*PlayerRenamedUpdate) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *PlayerRenamedUpdate) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+type PlayAsGuestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PlayAsGuestRequest) Reset() {
+	*x = PlayAsGuestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayAsGuestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayAsGuestRequest) ProtoMessage() {}
+
+func (x *PlayAsGuestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayAsGuestRequest.ProtoReflect.Descriptor instead.
+func (*PlayAsGuestRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{109}
+}
+
+type PlayAsGuestReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcome *Outcome `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+}
+
+func (x *PlayAsGuestReply) Reset() {
+	*x = PlayAsGuestReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayAsGuestReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayAsGuestReply) ProtoMessage() {}
+
+func (x *PlayAsGuestReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayAsGuestReply.ProtoReflect.Descriptor instead.
+func (*PlayAsGuestReply) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *PlayAsGuestReply) GetOutcome() *Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+type RateLimitUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcome *Outcome `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+}
+
+func (x *RateLimitUpdate) Reset() {
+	*x = RateLimitUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateLimitUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitUpdate) ProtoMessage() {}
+
+func (x *RateLimitUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitUpdate.ProtoReflect.Descriptor instead.
+func (*RateLimitUpdate) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *RateLimitUpdate) GetOutcome() *Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+type ListClientsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListClientsRequest) Reset() {
+	*x = ListClientsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListClientsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClientsRequest) ProtoMessage() {}
+
+func (x *ListClientsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClientsRequest.ProtoReflect.Descriptor instead.
+func (*ListClientsRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{112}
+}
+
+type ListClientsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Clients []*AdminClient `protobuf:"bytes,1,rep,name=clients,proto3" json:"clients,omitempty"`
+}
+
+func (x *ListClientsReply) Reset() {
+	*x = ListClientsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListClientsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClientsReply) ProtoMessage() {}
+
+func (x *ListClientsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClientsReply.ProtoReflect.Descriptor instead.
+func (*ListClientsReply) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ListClientsReply) GetClients() []*AdminClient {
+	if x != nil {
+		return x.Clients
+	}
+	return nil
+}
+
+type AdminClient struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PlayerId       string `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Connected      bool   `protobuf:"varint,3,opt,name=connected,proto3" json:"connected,omitempty"`
+	LastSeenUnix   int64  `protobuf:"varint,4,opt,name=last_seen_unix,json=lastSeenUnix,proto3" json:"last_seen_unix,omitempty"`
+	PendingUpdates int32  `protobuf:"varint,5,opt,name=pending_updates,json=pendingUpdates,proto3" json:"pending_updates,omitempty"`
+}
+
+func (x *AdminClient) Reset() {
+	*x = AdminClient{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminClient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminClient) ProtoMessage() {}
+
+func (x *AdminClient) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminClient.ProtoReflect.Descriptor instead.
+func (*AdminClient) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *AdminClient) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AdminClient) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *AdminClient) GetConnected() bool {
+	if x != nil {
+		return x.Connected
+	}
+	return false
+}
+
+func (x *AdminClient) GetLastSeenUnix() int64 {
+	if x != nil {
+		return x.LastSeenUnix
+	}
+	return 0
+}
+
+func (x *AdminClient) GetPendingUpdates() int32 {
+	if x != nil {
+		return x.PendingUpdates
+	}
+	return 0
+}
+
+type ListPlayersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListPlayersRequest) Reset() {
+	*x = ListPlayersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPlayersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlayersRequest) ProtoMessage() {}
+
+func (x *ListPlayersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlayersRequest.ProtoReflect.Descriptor instead.
+func (*ListPlayersRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{115}
+}
+
+type ListPlayersReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Players []*AdminPlayer `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+}
+
+func (x *ListPlayersReply) Reset() {
+	*x = ListPlayersReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPlayersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlayersReply) ProtoMessage() {}
+
+func (x *ListPlayersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlayersReply.ProtoReflect.Descriptor instead.
+func (*ListPlayersReply) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ListPlayersReply) GetPlayers() []*AdminPlayer {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+type AdminPlayer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DisplayName string   `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Guest       bool     `protobuf:"varint,4,opt,name=guest,proto3" json:"guest,omitempty"`
+	ClientId    string   `protobuf:"bytes,5,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Presence    Presence `protobuf:"varint,6,opt,name=presence,proto3,enum=server2.Presence" json:"presence,omitempty"`
+	LobbyId     string   `protobuf:"bytes,7,opt,name=lobby_id,json=lobbyId,proto3" json:"lobby_id,omitempty"`
+	GameId      string   `protobuf:"bytes,8,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Banned      bool     `protobuf:"varint,9,opt,name=banned,proto3" json:"banned,omitempty"`
+	BanReason   string   `protobuf:"bytes,10,opt,name=ban_reason,json=banReason,proto3" json:"ban_reason,omitempty"`
+	// ban_expires_unix is 0 for a permanent ban.
+	BanExpiresUnix int64 `protobuf:"varint,11,opt,name=ban_expires_unix,json=banExpiresUnix,proto3" json:"ban_expires_unix,omitempty"`
+}
+
+func (x *AdminPlayer) Reset() {
+	*x = AdminPlayer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminPlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminPlayer) ProtoMessage() {}
+
+func (x *AdminPlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminPlayer.ProtoReflect.Descriptor instead.
+func (*AdminPlayer) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *AdminPlayer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AdminPlayer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminPlayer) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *AdminPlayer) GetGuest() bool {
+	if x != nil {
+		return x.Guest
+	}
+	return false
+}
+
+func (x *AdminPlayer) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *AdminPlayer) GetPresence() Presence {
+	if x != nil {
+		return x.Presence
+	}
+	return Presence_OFFLINE
+}
+
+func (x *AdminPlayer) GetLobbyId() string {
+	if x != nil {
+		return x.LobbyId
+	}
+	return ""
+}
+
+func (x *AdminPlayer) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *AdminPlayer) GetBanned() bool {
+	if x != nil {
+		return x.Banned
+	}
+	return false
+}
+
+func (x *AdminPlayer) GetBanReason() string {
+	if x != nil {
+		return x.BanReason
+	}
+	return ""
+}
+
+func (x *AdminPlayer) GetBanExpiresUnix() int64 {
+	if x != nil {
+		return x.BanExpiresUnix
+	}
+	return 0
+}
+
+type GetGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *GetGameRequest) Reset() {
+	*x = GetGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameRequest) ProtoMessage() {}
+
+func (x *GetGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameRequest.ProtoReflect.Descriptor instead.
+func (*GetGameRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *GetGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type AdminGame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Board        []string        `protobuf:"bytes,2,rep,name=board,proto3" json:"board,omitempty"`
+	MoverXId     string          `protobuf:"bytes,3,opt,name=mover_x_id,json=moverXId,proto3" json:"mover_x_id,omitempty"`
+	MoverOId     string          `protobuf:"bytes,4,opt,name=mover_o_id,json=moverOId,proto3" json:"mover_o_id,omitempty"`
+	MoverId      string          `protobuf:"bytes,5,opt,name=mover_id,json=moverId,proto3" json:"mover_id,omitempty"`
+	WinnerId     string          `protobuf:"bytes,6,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
+	Result       AdminGameResult `protobuf:"varint,7,opt,name=result,proto3,enum=server2.AdminGameResult" json:"result,omitempty"`
+	LobbyId      string          `protobuf:"bytes,8,opt,name=lobby_id,json=lobbyId,proto3" json:"lobby_id,omitempty"`
+	TournamentId string          `protobuf:"bytes,9,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	SeriesId     string          `protobuf:"bytes,10,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+}
+
+func (x *AdminGame) Reset() {
+	*x = AdminGame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGame) ProtoMessage() {}
+
+func (x *AdminGame) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGame.ProtoReflect.Descriptor instead.
+func (*AdminGame) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *AdminGame) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AdminGame) GetBoard() []string {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *AdminGame) GetMoverXId() string {
+	if x != nil {
+		return x.MoverXId
+	}
+	return ""
+}
+
+func (x *AdminGame) GetMoverOId() string {
+	if x != nil {
+		return x.MoverOId
+	}
+	return ""
+}
+
+func (x *AdminGame) GetMoverId() string {
+	if x != nil {
+		return x.MoverId
+	}
+	return ""
+}
+
+func (x *AdminGame) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+func (x *AdminGame) GetResult() AdminGameResult {
+	if x != nil {
+		return x.Result
+	}
+	return AdminGameResult_GAME_INITIAL
+}
+
+func (x *AdminGame) GetLobbyId() string {
+	if x != nil {
+		return x.LobbyId
+	}
+	return ""
+}
+
+func (x *AdminGame) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *AdminGame) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+type GetLobbyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LobbyId string `protobuf:"bytes,1,opt,name=lobby_id,json=lobbyId,proto3" json:"lobby_id,omitempty"`
+}
+
+func (x *GetLobbyRequest) Reset() {
+	*x = GetLobbyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLobbyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLobbyRequest) ProtoMessage() {}
+
+func (x *GetLobbyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLobbyRequest.ProtoReflect.Descriptor instead.
+func (*GetLobbyRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *GetLobbyRequest) GetLobbyId() string {
+	if x != nil {
+		return x.LobbyId
+	}
+	return ""
+}
+
+type AdminLobby struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	HostId         string    `protobuf:"bytes,3,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	PlayerIds      []string  `protobuf:"bytes,4,rep,name=player_ids,json=playerIds,proto3" json:"player_ids,omitempty"`
+	ReadyPlayerIds []string  `protobuf:"bytes,5,rep,name=ready_player_ids,json=readyPlayerIds,proto3" json:"ready_player_ids,omitempty"`
+	PlayQueue      []string  `protobuf:"bytes,6,rep,name=play_queue,json=playQueue,proto3" json:"play_queue,omitempty"`
+	Mode           LobbyMode `protobuf:"varint,7,opt,name=mode,proto3,enum=server2.LobbyMode" json:"mode,omitempty"`
+	GameId         string    `protobuf:"bytes,8,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *AdminLobby) Reset() {
+	*x = AdminLobby{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLobby) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLobby) ProtoMessage() {}
+
+func (x *AdminLobby) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLobby.ProtoReflect.Descriptor instead.
+func (*AdminLobby) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *AdminLobby) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AdminLobby) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminLobby) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *AdminLobby) GetPlayerIds() []string {
+	if x != nil {
+		return x.PlayerIds
+	}
+	return nil
+}
+
+func (x *AdminLobby) GetReadyPlayerIds() []string {
+	if x != nil {
+		return x.ReadyPlayerIds
+	}
+	return nil
+}
+
+func (x *AdminLobby) GetPlayQueue() []string {
+	if x != nil {
+		return x.PlayQueue
+	}
+	return nil
+}
+
+func (x *AdminLobby) GetMode() LobbyMode {
+	if x != nil {
+		return x.Mode
+	}
+	return LobbyMode_STANDARD
+}
+
+func (x *AdminLobby) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type EndGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string           `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Result ForcedGameResult `protobuf:"varint,2,opt,name=result,proto3,enum=server2.ForcedGameResult" json:"result,omitempty"`
+	// winner_id names the winning player when result is FORCED_WIN.
+	WinnerId string `protobuf:"bytes,3,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
+}
+
+func (x *EndGameRequest) Reset() {
+	*x = EndGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndGameRequest) ProtoMessage() {}
+
+func (x *EndGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndGameRequest.ProtoReflect.Descriptor instead.
+func (*EndGameRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *EndGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *EndGameRequest) GetResult() ForcedGameResult {
+	if x != nil {
+		return x.Result
+	}
+	return ForcedGameResult_FORCED_DRAW
+}
+
+func (x *EndGameRequest) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+type CloseLobbyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LobbyId string `protobuf:"bytes,1,opt,name=lobby_id,json=lobbyId,proto3" json:"lobby_id,omitempty"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *CloseLobbyRequest) Reset() {
+	*x = CloseLobbyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseLobbyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseLobbyRequest) ProtoMessage() {}
+
+func (x *CloseLobbyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseLobbyRequest.ProtoReflect.Descriptor instead.
+func (*CloseLobbyRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *CloseLobbyRequest) GetLobbyId() string {
+	if x != nil {
+		return x.LobbyId
+	}
+	return ""
+}
+
+func (x *CloseLobbyRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type KickPlayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Reason   string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *KickPlayerRequest) Reset() {
+	*x = KickPlayerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KickPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickPlayerRequest) ProtoMessage() {}
+
+func (x *KickPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickPlayerRequest.ProtoReflect.Descriptor instead.
+func (*KickPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *KickPlayerRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *KickPlayerRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type BanPlayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Reason   string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// duration_seconds suspends the player for that long. 0 bans the
+	// player until the ban is lifted.
+	DurationSeconds int64 `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+}
+
+func (x *BanPlayerRequest) Reset() {
+	*x = BanPlayerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BanPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanPlayerRequest) ProtoMessage() {}
+
+func (x *BanPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanPlayerRequest.ProtoReflect.Descriptor instead.
+func (*BanPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *BanPlayerRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *BanPlayerRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BanPlayerRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type UnbanPlayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+}
+
+func (x *UnbanPlayerRequest) Reset() {
+	*x = UnbanPlayerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnbanPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbanPlayerRequest) ProtoMessage() {}
+
+func (x *UnbanPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbanPlayerRequest.ProtoReflect.Descriptor instead.
+func (*UnbanPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *UnbanPlayerRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type GetPlayerBansRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+}
+
+func (x *GetPlayerBansRequest) Reset() {
+	*x = GetPlayerBansRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlayerBansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerBansRequest) ProtoMessage() {}
+
+func (x *GetPlayerBansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerBansRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerBansRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *GetPlayerBansRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type GetPlayerBansReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bans []*AdminBan `protobuf:"bytes,1,rep,name=bans,proto3" json:"bans,omitempty"`
+}
+
+func (x *GetPlayerBansReply) Reset() {
+	*x = GetPlayerBansReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlayerBansReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerBansReply) ProtoMessage() {}
+
+func (x *GetPlayerBansReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerBansReply.ProtoReflect.Descriptor instead.
+func (*GetPlayerBansReply) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *GetPlayerBansReply) GetBans() []*AdminBan {
+	if x != nil {
+		return x.Bans
+	}
+	return nil
+}
+
+type AdminBan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason        string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	BannedAtUnix  int64  `protobuf:"varint,2,opt,name=banned_at_unix,json=bannedAtUnix,proto3" json:"banned_at_unix,omitempty"`
+	ExpiresAtUnix int64  `protobuf:"varint,3,opt,name=expires_at_unix,json=expiresAtUnix,proto3" json:"expires_at_unix,omitempty"`
+	LiftedAtUnix  int64  `protobuf:"varint,4,opt,name=lifted_at_unix,json=liftedAtUnix,proto3" json:"lifted_at_unix,omitempty"`
+	Active        bool   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *AdminBan) Reset() {
+	*x = AdminBan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminBan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminBan) ProtoMessage() {}
+
+func (x *AdminBan) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminBan.ProtoReflect.Descriptor instead.
+func (*AdminBan) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *AdminBan) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AdminBan) GetBannedAtUnix() int64 {
+	if x != nil {
+		return x.BannedAtUnix
+	}
+	return 0
+}
+
+func (x *AdminBan) GetExpiresAtUnix() int64 {
+	if x != nil {
+		return x.ExpiresAtUnix
+	}
+	return 0
+}
+
+func (x *AdminBan) GetLiftedAtUnix() int64 {
+	if x != nil {
+		return x.LiftedAtUnix
+	}
+	return 0
+}
+
+func (x *AdminBan) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type BroadcastMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *BroadcastMessageRequest) Reset() {
+	*x = BroadcastMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BroadcastMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastMessageRequest) ProtoMessage() {}
+
+func (x *BroadcastMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastMessageRequest.ProtoReflect.Descriptor instead.
+func (*BroadcastMessageRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *BroadcastMessageRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type BroadcastMessageReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Recipients int32 `protobuf:"varint,1,opt,name=recipients,proto3" json:"recipients,omitempty"`
+}
+
+func (x *BroadcastMessageReply) Reset() {
+	*x = BroadcastMessageReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BroadcastMessageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastMessageReply) ProtoMessage() {}
+
+func (x *BroadcastMessageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastMessageReply.ProtoReflect.Descriptor instead.
+func (*BroadcastMessageReply) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *BroadcastMessageReply) GetRecipients() int32 {
+	if x != nil {
+		return x.Recipients
+	}
+	return 0
+}
+
+type SystemAnnouncementUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Announcement *Announcement `protobuf:"bytes,1,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	// withdrawn is set when the announcement should no longer be shown.
+	Withdrawn bool `protobuf:"varint,2,opt,name=withdrawn,proto3" json:"withdrawn,omitempty"`
+}
+
+func (x *SystemAnnouncementUpdate) Reset() {
+	*x = SystemAnnouncementUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemAnnouncementUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemAnnouncementUpdate) ProtoMessage() {}
+
+func (x *SystemAnnouncementUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemAnnouncementUpdate.ProtoReflect.Descriptor instead.
+func (*SystemAnnouncementUpdate) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *SystemAnnouncementUpdate) GetAnnouncement() *Announcement {
+	if x != nil {
+		return x.Announcement
+	}
+	return nil
+}
+
+func (x *SystemAnnouncementUpdate) GetWithdrawn() bool {
+	if x != nil {
+		return x.Withdrawn
+	}
+	return false
+}
+
+type Announcement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Message       string               `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Severity      AnnouncementSeverity `protobuf:"varint,3,opt,name=severity,proto3,enum=server2.AnnouncementSeverity" json:"severity,omitempty"`
+	CreatedAtUnix int64                `protobuf:"varint,4,opt,name=created_at_unix,json=createdAtUnix,proto3" json:"created_at_unix,omitempty"`
+	// expires_at_unix is 0 for an announcement that stays until withdrawn.
+	ExpiresAtUnix int64 `protobuf:"varint,5,opt,name=expires_at_unix,json=expiresAtUnix,proto3" json:"expires_at_unix,omitempty"`
+}
+
+func (x *Announcement) Reset() {
+	*x = Announcement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Announcement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Announcement) ProtoMessage() {}
+
+func (x *Announcement) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Announcement.ProtoReflect.Descriptor instead.
+func (*Announcement) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *Announcement) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Announcement) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Announcement) GetSeverity() AnnouncementSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return AnnouncementSeverity_INFO
+}
+
+func (x *Announcement) GetCreatedAtUnix() int64 {
+	if x != nil {
+		return x.CreatedAtUnix
+	}
+	return 0
+}
+
+func (x *Announcement) GetExpiresAtUnix() int64 {
+	if x != nil {
+		return x.ExpiresAtUnix
+	}
+	return 0
+}
+
+type CreateAnnouncementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message  string               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Severity AnnouncementSeverity `protobuf:"varint,2,opt,name=severity,proto3,enum=server2.AnnouncementSeverity" json:"severity,omitempty"`
+	// duration_seconds is how long the announcement is shown. 0 shows it
+	// until it is deleted.
+	DurationSeconds int64 `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+}
+
+func (x *CreateAnnouncementRequest) Reset() {
+	*x = CreateAnnouncementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAnnouncementRequest) ProtoMessage() {}
+
+func (x *CreateAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*CreateAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *CreateAnnouncementRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateAnnouncementRequest) GetSeverity() AnnouncementSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return AnnouncementSeverity_INFO
+}
+
+func (x *CreateAnnouncementRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type ListAnnouncementsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListAnnouncementsRequest) Reset() {
+	*x = ListAnnouncementsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAnnouncementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnnouncementsRequest) ProtoMessage() {}
+
+func (x *ListAnnouncementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnnouncementsRequest.ProtoReflect.Descriptor instead.
+func (*ListAnnouncementsRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{135}
+}
+
+type ListAnnouncementsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Announcements      []*Announcement `protobuf:"bytes,1,rep,name=announcements,proto3" json:"announcements,omitempty"`
+	MaintenanceMode    bool            `protobuf:"varint,2,opt,name=maintenance_mode,json=maintenanceMode,proto3" json:"maintenance_mode,omitempty"`
+	MaintenanceMessage string          `protobuf:"bytes,3,opt,name=maintenance_message,json=maintenanceMessage,proto3" json:"maintenance_message,omitempty"`
+}
+
+func (x *ListAnnouncementsReply) Reset() {
+	*x = ListAnnouncementsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAnnouncementsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnnouncementsReply) ProtoMessage() {}
+
+func (x *ListAnnouncementsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnnouncementsReply.ProtoReflect.Descriptor instead.
+func (*ListAnnouncementsReply) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *ListAnnouncementsReply) GetAnnouncements() []*Announcement {
+	if x != nil {
+		return x.Announcements
+	}
+	return nil
+}
+
+func (x *ListAnnouncementsReply) GetMaintenanceMode() bool {
+	if x != nil {
+		return x.MaintenanceMode
+	}
+	return false
+}
+
+func (x *ListAnnouncementsReply) GetMaintenanceMessage() string {
+	if x != nil {
+		return x.MaintenanceMessage
+	}
+	return ""
+}
+
+type DeleteAnnouncementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteAnnouncementRequest) Reset() {
+	*x = DeleteAnnouncementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAnnouncementRequest) ProtoMessage() {}
+
+func (x *DeleteAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *DeleteAnnouncementRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SetMaintenanceModeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// message is shown to players whose new games are turned away.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *SetMaintenanceModeRequest) Reset() {
+	*x = SetMaintenanceModeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMaintenanceModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMaintenanceModeRequest) ProtoMessage() {}
+
+func (x *SetMaintenanceModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMaintenanceModeRequest.ProtoReflect.Descriptor instead.
+func (*SetMaintenanceModeRequest) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *SetMaintenanceModeRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetMaintenanceModeRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// WebSocketAuth is the first message a WebSocket client sends when it does
+// not pass public_key in the query string.
+type WebSocketAuth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	ClientId  string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (x *WebSocketAuth) Reset() {
+	*x = WebSocketAuth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server2_tctxto2_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebSocketAuth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebSocketAuth) ProtoMessage() {}
+
+func (x *WebSocketAuth) ProtoReflect() protoreflect.Message {
+	mi := &file_server2_tctxto2_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebSocketAuth.ProtoReflect.Descriptor instead.
+func (*WebSocketAuth) Descriptor() ([]byte, []int) {
+	return file_server2_tctxto2_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *WebSocketAuth) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *WebSocketAuth) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+var File_server2_tctxto2_proto protoreflect.FileDescriptor
+
+var file_server2_tctxto2_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2f, 0x74, 0x63, 0x74, 0x78, 0x74, 0x6f,
+	0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32,
+	0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xed, 0x16, 0x0a, 0x0c, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x0f, 0x73, 0x69,
+	0x67, 0x6e, 0x5f, 0x75, 0x70, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x55, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x73,
+	0x69, 0x67, 0x6e, 0x55, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x0f,
+	0x73, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e,
+	0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
+	0x0d, 0x73, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43,
+	0x0a, 0x10, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x32, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x6f,
+	0x62, 0x62, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x12, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x12, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x6c, 0x6f, 0x62,
+	0x62, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x4c,
+	0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x10, 0x6a,
+	0x6f, 0x69, 0x6e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x53, 0x0a, 0x16, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x5f, 0x6d, 0x79, 0x5f, 0x6c, 0x6f, 0x62, 0x62,
+	0x79, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x4d,
+	0x79, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
+	0x13, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x79, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x67,
+	0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
+	0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x46, 0x0a, 0x11, 0x6d, 0x61, 0x6b, 0x65, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x6d, 0x61, 0x6b, 0x65, 0x4d,
+	0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x0f, 0x72, 0x65,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x52, 0x65,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0e,
+	0x72, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x75,
+	0x0a, 0x22, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x32, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x1e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x14, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4c, 0x6f,
+	0x62, 0x62, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x12, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x17, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x5f, 0x74, 0x6f, 0x5f, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x32, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x14, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x54, 0x6f, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x71,
+	0x0a, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x5f, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x1d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x4c, 0x6f, 0x62, 0x62, 0x79,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x46, 0x0a, 0x11, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x73, 0x65, 0x74, 0x52, 0x65, 0x61,
+	0x64, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x16, 0x73, 0x65, 0x74,
+	0x5f, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x32, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x13, 0x73, 0x65, 0x74, 0x4c, 0x6f,
+	0x62, 0x62, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56,
+	0x0a, 0x17, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x50, 0x6c,
+	0x61, 0x79, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x14, 0x6a, 0x6f, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x59, 0x0a, 0x18, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x5f,
+	0x70, 0x6c, 0x61, 0x79, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x32, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x15, 0x6c, 0x65, 0x61, 0x76,
+	0x65, 0x50, 0x6c, 0x61, 0x79, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x5e, 0x0a, 0x19, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x17, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x58, 0x0a, 0x17, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4a, 0x6f, 0x69,
+	0x6e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x15, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5b, 0x0a, 0x18, 0x6c,
+	0x65, 0x61, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x16, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5b, 0x0a, 0x18, 0x77, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x32, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x16, 0x77,
+	0x61, 0x74, 0x63, 0x68, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x16, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e,
+	0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x14, 0x67, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x12,
+	0x61, 0x64, 0x64, 0x5f, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x32, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x10, 0x61, 0x64, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x15, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x5f, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32,
+	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x13, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x16, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x5f, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x14, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x5b, 0x0a, 0x18, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x1a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x43,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x16, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x5e, 0x0a, 0x19, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x1b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x17, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x43,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x5b, 0x0a, 0x18, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x16, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x14,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x32, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x12, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a,
+	0x16, 0x75, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x14,
+	0x75, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x58, 0x0a, 0x17, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x15, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x58,
+	0x0a, 0x17, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x15, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x16, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x14, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x50, 0x0a, 0x15, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x61, 0x73, 0x5f, 0x67, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x41, 0x73, 0x47,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x12, 0x70,
+	0x6c, 0x61, 0x79, 0x41, 0x73, 0x47, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xb1, 0x29, 0x0a, 0x0c, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x70, 0x69,
+	0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x32, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x12,
+	0x5b, 0x0a, 0x18, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x48, 0x00, 0x52, 0x16, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x48, 0x0a, 0x11,
+	0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x32, 0x2e, 0x4e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x75,
+	0x70, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x70, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x55, 0x70, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x3a, 0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x72, 0x65,
+	0x70, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x32, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48,
+	0x00, 0x52, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3d,
+	0x0a, 0x0e, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32,
+	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52,
+	0x0c, 0x73, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x43, 0x0a,
+	0x10, 0x6d, 0x79, 0x5f, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x32, 0x2e, 0x4d, 0x79, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x48, 0x00, 0x52, 0x0e, 0x6d, 0x79, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x12, 0x53, 0x0a, 0x16, 0x6d, 0x79, 0x5f, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x6a,
+	0x6f, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4d, 0x79, 0x4c,
+	0x6f, 0x62, 0x62, 0x79, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x48, 0x00, 0x52, 0x13, 0x6d, 0x79, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4a, 0x6f, 0x69, 0x6e, 0x65,
+	0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x53, 0x0a, 0x16, 0x6d, 0x79, 0x5f, 0x6c, 0x6f,
+	0x62, 0x62, 0x79, 0x5f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x32, 0x2e, 0x4d, 0x79, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x72, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x13, 0x6d, 0x79, 0x4c, 0x6f, 0x62, 0x62, 0x79,
+	0x4c, 0x65, 0x61, 0x76, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x49, 0x0a, 0x12,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x72, 0x65, 0x70,
+	0x6c, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x62,
+	0x62, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x43, 0x0a, 0x10, 0x6a, 0x6f, 0x69, 0x6e, 0x5f,
+	0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4a, 0x6f, 0x69, 0x6e,
+	0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x0e, 0x6a, 0x6f,
+	0x69, 0x6e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4d, 0x0a, 0x14,
+	0x6c, 0x65, 0x61, 0x76, 0x65, 0x5f, 0x6d, 0x79, 0x5f, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x72,
+	0x65, 0x70, 0x6c, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x32, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x79, 0x4c, 0x6f, 0x62, 0x62,
+	0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x11, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d,
+	0x79, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x46, 0x0a, 0x11, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x48, 0x00, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x40, 0x0a, 0x0f, 0x6d, 0x61, 0x6b, 0x65, 0x5f, 0x6d, 0x6f, 0x76, 0x65,
+	0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x0d, 0x6d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x36, 0x0a, 0x0b, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x32, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48,
+	0x00, 0x52, 0x0a, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a,
+	0x0d, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x57,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x77,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x64,
+	0x72, 0x61, 0x77, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x72, 0x61, 0x77, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x67, 0x61, 0x6d, 0x65,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32,
+	0x2e, 0x4e, 0x65, 0x78, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x48, 0x00, 0x52, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x72, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x4f, 0x0a, 0x14, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00,
+	0x52, 0x12, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x5f, 0x0a, 0x1a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x32, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x17, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x3f, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64,
+	0x65, 0x6e, 0x69, 0x65, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6e,
+	0x69, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65,
+	0x6e, 0x69, 0x65, 0x64, 0x12, 0x45, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0f, 0x72, 0x65, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x42, 0x0a, 0x0f, 0x72,
+	0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x19,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x52,
+	0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52,
+	0x0e, 0x72, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x6f, 0x0a, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x5f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65,
+	0x70, 0x6c, 0x79, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x32, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x48, 0x00, 0x52, 0x1c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x49, 0x0a, 0x12, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x10, 0x6c, 0x6f, 0x62, 0x62, 0x79,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4c, 0x0a, 0x13, 0x6c,
+	0x6f, 0x62, 0x62, 0x79, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x32, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x11, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x50, 0x0a, 0x15, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x72, 0x65, 0x70,
+	0x6c, 0x79, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x32, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x4c, 0x6f, 0x62, 0x62, 0x79,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x12, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54,
+	0x6f, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x58, 0x0a, 0x17, 0x6c,
+	0x6f, 0x62, 0x62, 0x79, 0x5f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x15,
+	0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x6b, 0x0a, 0x1e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64,
+	0x5f, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x4c,
+	0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x1b, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x4c, 0x6f,
+	0x62, 0x62, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x71, 0x0a, 0x20, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x1d, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x0f, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x61,
+	0x64, 0x79, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64,
+	0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x65, 0x74, 0x52, 0x65, 0x61,
+	0x64, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x59, 0x0a, 0x18, 0x6c, 0x6f, 0x62, 0x62, 0x79,
+	0x5f, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x32, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x61, 0x64, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x15, 0x6c, 0x6f, 0x62,
+	0x62, 0x79, 0x52, 0x65, 0x61, 0x64, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x4d, 0x0a, 0x14, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f,
+	0x62, 0x62, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x11,
+	0x73, 0x65, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x50, 0x0a, 0x15, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x50,
+	0x6c, 0x61, 0x79, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52,
+	0x12, 0x6a, 0x6f, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x53, 0x0a, 0x16, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x5f, 0x70, 0x6c, 0x61,
+	0x79, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x25, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4c, 0x65,
+	0x61, 0x76, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x48, 0x00, 0x52, 0x13, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x46, 0x0a, 0x11, 0x70, 0x6c, 0x61, 0x79,
+	0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x26, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x51, 0x75, 0x65, 0x75, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52,
+	0x0f, 0x70, 0x6c, 0x61, 0x79, 0x51, 0x75, 0x65, 0x75, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x58, 0x0a, 0x17, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x27, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x48, 0x00, 0x52, 0x15, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x52, 0x0a, 0x15, 0x6a, 0x6f,
+	0x69, 0x6e, 0x5f, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65,
+	0x70, 0x6c, 0x79, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x32, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x13, 0x6a, 0x6f, 0x69, 0x6e, 0x54,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x55,
+	0x0a, 0x16, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52,
+	0x14, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x55, 0x0a, 0x16, 0x77, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x74,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18,
+	0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x14, 0x77, 0x61, 0x74, 0x63, 0x68, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4f, 0x0a, 0x14,
+	0x67, 0x65, 0x74, 0x5f, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72,
+	0x65, 0x70, 0x6c, 0x79, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x12, 0x67, 0x65, 0x74, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x5e, 0x0a,
+	0x19, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x48, 0x00, 0x52, 0x17, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x4c, 0x0a,
+	0x13, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x32, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x11, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x5b, 0x0a, 0x18, 0x72,
+	0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00,
+	0x52, 0x16, 0x72, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f,
+	0x77, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x43, 0x0a, 0x10, 0x61, 0x64, 0x64, 0x5f,
+	0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x2f, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x41, 0x64, 0x64,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x0e, 0x61,
+	0x64, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4c, 0x0a,
+	0x13, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x5f, 0x72,
+	0x65, 0x70, 0x6c, 0x79, 0x18, 0x30, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x32, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4f, 0x0a, 0x14, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x5f, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65,
+	0x70, 0x6c, 0x79, 0x18, 0x31, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x12, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x49, 0x0a, 0x12,
+	0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x32, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x10, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x55, 0x0a, 0x16, 0x66, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x33, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x32, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x14, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x55,
+	0x0a, 0x16, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x34, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52,
+	0x14, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x5e, 0x0a, 0x19, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x35, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x32, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x17, 0x63, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x58, 0x0a, 0x17, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64,
+	0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79,
+	0x18, 0x36, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x15, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x64, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x55, 0x0a, 0x16, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x37, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00,
+	0x52, 0x14, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x5e, 0x0a, 0x19, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x38, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x32, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x17, 0x63,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x49, 0x0a, 0x12, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x39, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52,
+	0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x4f, 0x0a, 0x14, 0x75, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x12,
+	0x75, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x46, 0x0a, 0x11, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x3b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x73,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x4c, 0x69, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x52, 0x0a, 0x15, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x72, 0x65,
+	0x70, 0x6c, 0x79, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x32, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x13, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x52,
+	0x0a, 0x15, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x13, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x4f, 0x0a, 0x14, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52,
+	0x12, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x52, 0x0a, 0x15, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x72, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x64, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x3f, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x48, 0x00, 0x52, 0x13, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x4a, 0x0a, 0x13, 0x70, 0x6c, 0x61, 0x79, 0x5f,
+	0x61, 0x73, 0x5f, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x40,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x41, 0x73, 0x47, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48,
+	0x00, 0x52, 0x10, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x73, 0x47, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x46, 0x0a, 0x11, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x41, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x72, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x61, 0x0a, 0x1a, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x42, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x48, 0x00, 0x52, 0x18, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x41, 0x6e, 0x6e, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x06, 0x0a,
+	0x04, 0x50, 0x69, 0x6e, 0x67, 0x22, 0x97, 0x01, 0x0a, 0x05, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e,
+	0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22,
+	0x2c, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x35, 0x0a,
+	0x16, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x22, 0x3f, 0x0a, 0x10, 0x4e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32,
+	0x2e, 0x4e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x37, 0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x73, 0x73, 0x22, 0x39,
+	0x0a, 0x0b, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a,
+	0x07, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
+	0x52, 0x07, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x37, 0x0a, 0x0d, 0x53, 0x69, 0x67,
+	0x6e, 0x55, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x73, 0x73, 0x22, 0x39, 0x0a, 0x0b, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x70, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x10, 0x0a,
+	0x0e, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x3a, 0x0a, 0x0c, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x5d, 0x0a, 0x07, 0x4f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x36, 0x0a, 0x0e, 0x4d, 0x79,
+	0x4c, 0x6f, 0x62, 0x62, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x24, 0x0a, 0x05,
+	0x6c, 0x6f, 0x62, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x05, 0x6c, 0x6f, 0x62,
+	0x62, 0x79, 0x22, 0x3e, 0x0a, 0x13, 0x4d, 0x79, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4a, 0x6f, 0x69,
+	0x6e, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x32, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x22, 0x3e, 0x0a, 0x13, 0x4d, 0x79, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4c, 0x65, 0x61,
+	0x76, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x32, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x79, 0x4c, 0x6f, 0x62,
+	0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3f, 0x0a, 0x11, 0x4c, 0x65, 0x61,
+	0x76, 0x65, 0x4d, 0x79, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a,
+	0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x2d, 0x0a, 0x10, 0x4a, 0x6f,
+	0x69, 0x6e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x22, 0x3c, 0x0a, 0x0e, 0x4a, 0x6f, 0x69,
+	0x6e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07,
+	0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x3e, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07,
+	0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x48, 0x0a, 0x04, 0x4d, 0x6f, 0x76, 0x65, 0x12,
+	0x24, 0x0a, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x05,
+	0x6d, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x2f, 0x0a, 0x0a, 0x4d, 0x6f, 0x76, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x21, 0x0a, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x04, 0x6d, 0x6f,
+	0x76, 0x65, 0x22, 0x23, 0x0a, 0x0f, 0x4e, 0x65, 0x78, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x72, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x79, 0x6f, 0x75, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x03, 0x79, 0x6f, 0x75, 0x22, 0x2d, 0x0a, 0x0f, 0x4d, 0x61, 0x6b, 0x65, 0x4d,
+	0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3b, 0x0a, 0x0d, 0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f,
+	0x76, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63,
+	0x6f, 0x6d, 0x65, 0x22, 0x28, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x62,
+	0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x6a, 0x0a,
+	0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x31, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x31, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x32, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x32, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x62, 0x65, 0x73, 0x74, 0x4f, 0x66, 0x22, 0x3d, 0x0a, 0x0f, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07,
+	0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52,
+	0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x5b, 0x0a, 0x0c, 0x57, 0x69, 0x6e, 0x6e,
+	0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x79, 0x6f, 0x75, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x79, 0x6f, 0x75, 0x12, 0x39, 0x0a, 0x0c, 0x74, 0x65,
+	0x63, 0x68, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x54, 0x65, 0x63, 0x68, 0x6e,
+	0x69, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x0c, 0x0a, 0x0a, 0x44, 0x72, 0x61, 0x77, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x22, 0x33, 0x0a, 0x0f, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x03, 0x79, 0x6f, 0x75, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4d, 0x6f,
+	0x76, 0x65, 0x72, 0x52, 0x03, 0x79, 0x6f, 0x75, 0x22, 0x2e, 0x0a, 0x12, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3b, 0x0a, 0x17, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x22, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x79, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x79, 0x65, 0x73, 0x22, 0x3a, 0x0a, 0x0c, 0x52, 0x65, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x44, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x22, 0x10, 0x0a, 0x0e, 0x52, 0x65, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x64, 0x0a, 0x16, 0x52,
+	0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x41, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x5f,
+	0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x10, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x22, 0x42, 0x0a, 0x1e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4a, 0x0a, 0x1c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32,
+	0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x22, 0x28, 0x0a, 0x12, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3e, 0x0a, 0x10, 0x4c,
+	0x6f, 0x62, 0x62, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x3d, 0x0a, 0x11, 0x4c,
+	0x6f, 0x62, 0x62, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x28, 0x0a, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4c, 0x6f, 0x62, 0x62,
+	0x79, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x69, 0x65, 0x73, 0x22, 0x37, 0x0a, 0x14, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x40, 0x0a, 0x12, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x4c,
+	0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x0f, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x05, 0x6c, 0x6f, 0x62,
+	0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x32, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x05, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x12,
+	0x29, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x51, 0x0a, 0x15, 0x4c, 0x6f, 0x62,
+	0x62, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x38, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32,
+	0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5c, 0x0a, 0x1d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x22, 0x49, 0x0a, 0x1b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x64, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0xa2, 0x01, 0x0a, 0x1d, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x07,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x32, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x27, 0x0a, 0x0f, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65,
+	0x61, 0x64, 0x79, 0x22, 0x3b, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e,
+	0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
+	0x22, 0x5a, 0x0a, 0x15, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x61, 0x64, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x61,
+	0x64, 0x79, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x61, 0x64, 0x79, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x49, 0x64, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x3d, 0x0a, 0x13,
+	0x53, 0x65, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4c, 0x6f, 0x62, 0x62,
+	0x79, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x3f, 0x0a, 0x11, 0x53,
+	0x65, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63,
+	0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x16, 0x0a, 0x14,
+	0x4a, 0x6f, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x40, 0x0a, 0x12, 0x4a, 0x6f, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x50,
+	0x6c, 0x61, 0x79, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x41, 0x0a, 0x13, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x0f, 0x50, 0x6c, 0x61, 0x79, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4c,
+	0x6f, 0x62, 0x62, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x2a,
+	0x0a, 0x11, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x6c,
+	0x61, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x70, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x32, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x31, 0x0a, 0x14, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x43,
+	0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63,
+	0x6f, 0x6d, 0x65, 0x22, 0x3c, 0x0a, 0x15, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x22, 0x41, 0x0a, 0x13, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63,
+	0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x22, 0x3d, 0x0a, 0x16, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x14, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07,
+	0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x53, 0x0a, 0x16, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x61, 0x74, 0x63, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x77, 0x61, 0x74, 0x63, 0x68, 0x22, 0x42, 0x0a, 0x14,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e,
+	0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
+	0x22, 0x3b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x40, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22,
+	0xfa, 0x02, 0x0a, 0x0a, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x12, 0x2b, 0x0a, 0x08, 0x65, 0x6e,
+	0x74, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x08, 0x65,
+	0x6e, 0x74, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x32, 0x0a,
+	0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x12, 0x39, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x54,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xdf, 0x01, 0x0a,
+	0x0f, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x29, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x31, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x32, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x31, 0x12, 0x29, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x32, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x32, 0x12, 0x17, 0x0a, 0x07,
+	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67,
+	0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x22, 0xab,
+	0x01, 0x0a, 0x12, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x27, 0x0a, 0x06, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x32, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x77, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x72, 0x61, 0x77, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x6f,
+	0x73, 0x73, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x4e, 0x0a, 0x17,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xa3, 0x02, 0x0a,
+	0x11, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x62, 0x65, 0x73, 0x74, 0x4f, 0x66, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65,
+	0x73, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x67, 0x61, 0x6d, 0x65, 0x73, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x79,
+	0x6f, 0x75, 0x72, 0x5f, 0x77, 0x69, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x79, 0x6f, 0x75, 0x72, 0x57, 0x69, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x70, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x77, 0x69, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x6e, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x72, 0x61, 0x77, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x72,
+	0x61, 0x77, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x79, 0x6f, 0x75, 0x5f, 0x77, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x79, 0x6f, 0x75, 0x57, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x22, 0x33, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3c, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x32, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x3f, 0x0a, 0x11, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a,
+	0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x4b, 0x0a, 0x14, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x22, 0x40, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a,
+	0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
+	0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x60, 0x0a, 0x06, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x12, 0x27, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x08,
+	0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x08, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xb9, 0x01, 0x0a, 0x10,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x29, 0x0a, 0x07, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x46, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x52, 0x07, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x3c, 0x0a, 0x11, 0x69,
+	0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32,
+	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x10, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x11, 0x6f, 0x75, 0x74,
+	0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x10, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x3f, 0x0a, 0x14, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x27, 0x0a, 0x06, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x52, 0x06, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x22, 0x52, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x6f, 0x66, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x62, 0x65, 0x73, 0x74, 0x4f, 0x66, 0x22, 0x42, 0x0a, 0x14,
+	0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e,
+	0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
+	0x22, 0xb5, 0x01, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2f,
+	0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x12,
+	0x2f, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x6f, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x62, 0x65, 0x73, 0x74, 0x4f, 0x66, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x4b, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32,
+	0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x22, 0x54, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64,
+	0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x22, 0x43, 0x0a, 0x15, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e,
+	0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
+	0x22, 0x3b, 0x0a, 0x16, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x22, 0x42, 0x0a,
+	0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32,
+	0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x22, 0x7e, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x09,
+	0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x31,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x31, 0x0a, 0x12, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x3e, 0x0a, 0x10, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63,
+	0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x22, 0x33, 0x0a, 0x14, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x40, 0x0a, 0x12, 0x55, 0x6e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x3c, 0x0a, 0x0f, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x29,
+	0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0x55, 0x0a, 0x15, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x50, 0x61, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73,
+	0x22, 0x41, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63,
+	0x6f, 0x6d, 0x65, 0x22, 0x3f, 0x0a, 0x15, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x73, 0x73, 0x22, 0x41, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07,
+	0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x2a, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x73, 0x73, 0x22, 0x40, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x3e, 0x0a, 0x13, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x06,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x22, 0x14, 0x0a, 0x12, 0x50, 0x6c, 0x61, 0x79, 0x41, 0x73, 0x47,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x10, 0x50,
+	0x6c, 0x61, 0x79, 0x41, 0x73, 0x47, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x3d, 0x0a, 0x0f, 0x52,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2a,
+	0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x42, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x07, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0xa7, 0x01, 0x0a, 0x0b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12,
+	0x24, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x75, 0x6e, 0x69,
+	0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65,
+	0x6e, 0x55, 0x6e, 0x69, 0x78, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0x14,
+	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x42, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x32, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52,
+	0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0xcb, 0x02, 0x0a, 0x0b, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x67, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x67, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x2d, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67,
+	0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x62, 0x61, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x62, 0x61, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x10,
+	0x62, 0x61, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x75, 0x6e, 0x69, 0x78,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x62, 0x61, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x55, 0x6e, 0x69, 0x78, 0x22, 0x29, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49,
+	0x64, 0x22, 0xb4, 0x02, 0x0a, 0x09, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x0a, 0x6d, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x78,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x72,
+	0x58, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x0a, 0x6d, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x6f, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x72, 0x4f, 0x49,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x32, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c,
+	0x6f, 0x62, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c,
+	0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x22, 0x2c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c,
+	0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c,
+	0x6f, 0x62, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c,
+	0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x22, 0xf2, 0x01, 0x0a, 0x0a, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64,
+	0x73, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x61,
+	0x64, 0x79, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x6c, 0x61, 0x79, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x6c, 0x61, 0x79, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x32, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x79, 0x0a, 0x0e, 0x45,
+	0x6e, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32,
+	0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x6e,
+	0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x69,
+	0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x11, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4c,
+	0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c,
+	0x6f, 0x62, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c,
+	0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x48,
+	0x0a, 0x11, 0x4b, 0x69, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x72, 0x0a, 0x10, 0x42, 0x61, 0x6e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x31, 0x0a, 0x12,
+	0x55, 0x6e, 0x62, 0x61, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x33, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x61, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x42, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x25, 0x0a, 0x04, 0x62, 0x61,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x32, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6e, 0x52, 0x04, 0x62, 0x61, 0x6e,
+	0x73, 0x22, 0xae, 0x01, 0x0a, 0x08, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x12, 0x26, 0x0a, 0x0f,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x55, 0x6e, 0x69, 0x78, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x69,
+	0x66, 0x74, 0x65, 0x64, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x22, 0x33, 0x0a, 0x17, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x37, 0x0a, 0x15, 0x42, 0x72, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0x73, 0x0a, 0x18, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0c,
+	0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x41, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75,
+	0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x6e, 0x22, 0xc3, 0x01, 0x0a, 0x0c, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x39, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x41, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74,
+	0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0f, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x55,
+	0x6e, 0x69, 0x78, 0x12, 0x26, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61,
+	0x74, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x22, 0x9b, 0x01, 0x0a, 0x19,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e,
+	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x76, 0x65,
+	0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x29,
+	0x0a, 0x10, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb1, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x3b, 0x0a, 0x0d, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x32, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0d,
+	0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x29, 0x0a,
+	0x10, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x6d, 0x61, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2b, 0x0a, 0x19, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4f, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4b, 0x0a, 0x0d, 0x57, 0x65, 0x62, 0x53, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x2a, 0x4c, 0x0a, 0x0e, 0x4e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x45, 0x4c, 0x43, 0x4f, 0x4d,
+	0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x4f, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x0c, 0x0a,
+	0x08, 0x4d, 0x59, 0x5f, 0x4c, 0x4f, 0x42, 0x42, 0x59, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x47,
+	0x41, 0x4d, 0x45, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x4d, 0x41, 0x54, 0x43, 0x48,
+	0x10, 0x04, 0x2a, 0x15, 0x0a, 0x05, 0x4d, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x05, 0x0a, 0x01, 0x58,
+	0x10, 0x00, 0x12, 0x05, 0x0a, 0x01, 0x4f, 0x10, 0x01, 0x2a, 0x2e, 0x0a, 0x0c, 0x54, 0x65, 0x63,
+	0x68, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x4f, 0x5f,
+	0x50, 0x52, 0x4f, 0x42, 0x4c, 0x45, 0x4d, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x59, 0x5f,
+	0x46, 0x4f, 0x52, 0x46, 0x45, 0x49, 0x54, 0x10, 0x01, 0x2a, 0x57, 0x0a, 0x10, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a,
+	0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x43,
+	0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x45, 0x43, 0x4c,
+	0x49, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45,
+	0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x57, 0x49, 0x54, 0x48, 0x44, 0x52, 0x41, 0x57, 0x4e,
+	0x10, 0x04, 0x2a, 0x2e, 0x0a, 0x09, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x0c, 0x0a, 0x08, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x41, 0x52, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a,
+	0x0f, 0x57, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x59, 0x53, 0x5f, 0x4f, 0x4e,
+	0x10, 0x01, 0x2a, 0x53, 0x0a, 0x10, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x49, 0x4e, 0x47, 0x4c, 0x45,
+	0x5f, 0x45, 0x4c, 0x49, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x16,
+	0x0a, 0x12, 0x44, 0x4f, 0x55, 0x42, 0x4c, 0x45, 0x5f, 0x45, 0x4c, 0x49, 0x4d, 0x49, 0x4e, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f,
+	0x52, 0x4f, 0x42, 0x49, 0x4e, 0x10, 0x02, 0x2a, 0x52, 0x0a, 0x10, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x0c, 0x52,
+	0x45, 0x47, 0x49, 0x53, 0x54, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x0f, 0x0a,
+	0x0b, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x0c,
+	0x0a, 0x08, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09,
+	0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x3e, 0x0a, 0x08, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x46, 0x46, 0x4c, 0x49,
+	0x4e, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x01,
+	0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x5f, 0x4c, 0x4f, 0x42, 0x42, 0x59, 0x10, 0x02, 0x12, 0x0b,
+	0x0a, 0x07, 0x49, 0x4e, 0x5f, 0x47, 0x41, 0x4d, 0x45, 0x10, 0x03, 0x2a, 0x6b, 0x0a, 0x0f, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x10,
+	0x0a, 0x0c, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x10, 0x00,
+	0x12, 0x10, 0x0a, 0x0c, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x4f, 0x4e, 0x47, 0x4f, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x57, 0x49, 0x4e, 0x10, 0x02,
+	0x12, 0x0d, 0x0a, 0x09, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x44, 0x52, 0x41, 0x57, 0x10, 0x03, 0x12,
+	0x17, 0x0a, 0x13, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x57, 0x49, 0x4e, 0x5f, 0x42, 0x59, 0x5f, 0x46,
+	0x4f, 0x52, 0x46, 0x45, 0x49, 0x54, 0x10, 0x04, 0x2a, 0x33, 0x0a, 0x10, 0x46, 0x6f, 0x72, 0x63,
+	0x65, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0f, 0x0a, 0x0b,
+	0x46, 0x4f, 0x52, 0x43, 0x45, 0x44, 0x5f, 0x44, 0x52, 0x41, 0x57, 0x10, 0x00, 0x12, 0x0e, 0x0a,
+	0x0a, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x44, 0x5f, 0x57, 0x49, 0x4e, 0x10, 0x01, 0x2a, 0x3b, 0x0a,
+	0x14, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x76,
+	0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x00, 0x12,
+	0x0b, 0x0a, 0x07, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08,
+	0x43, 0x52, 0x49, 0x54, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x02, 0x32, 0xbc, 0x01, 0x0a, 0x09, 0x54,
+	0x69, 0x63, 0x54, 0x61, 0x63, 0x54, 0x6f, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x31, 0x0a, 0x06, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x15, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x32, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x1a, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x42, 0x69, 0x44, 0x69, 0x72, 0x12, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x1a, 0x15, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x32, 0xac, 0x08, 0x0a, 0x0e, 0x54, 0x69,
+	0x63, 0x54, 0x61, 0x63, 0x54, 0x6f, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x47, 0x0a, 0x0b,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x38,
+	0x0a, 0x07, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4c,
+	0x6f, 0x62, 0x62, 0x79, 0x12, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x47,
+	0x65, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f,
+	0x62, 0x62, 0x79, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x07, 0x45, 0x6e, 0x64, 0x47, 0x61, 0x6d, 0x65,
+	0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x45, 0x6e, 0x64, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x32, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0a, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x32, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0a, 0x4b, 0x69, 0x63, 0x6b, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e,
+	0x4b, 0x69, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x09, 0x42, 0x61, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x42, 0x61, 0x6e, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3c, 0x0a,
+	0x0b, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x32, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x61, 0x6e, 0x73, 0x12, 0x1d, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x42, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x42,
+	0x61, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x10, 0x42, 0x72,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x51, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6e, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6e, 0x6e, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x4a, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x32, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x12,
+	0x53, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x2e, 0x53, 0x65, 0x74,
+	0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x10, 0x5a, 0x0e, 0x74, 0x63, 0x74, 0x78,
+	0x74, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_server2_tctxto2_proto_rawDescOnce sync.Once
+	file_server2_tctxto2_proto_rawDescData = file_server2_tctxto2_proto_rawDesc
+)
+
+func file_server2_tctxto2_proto_rawDescGZIP() []byte {
+	file_server2_tctxto2_proto_rawDescOnce.Do(func() {
+		file_server2_tctxto2_proto_rawDescData = protoimpl.X.CompressGZIP(file_server2_tctxto2_proto_rawDescData)
+	})
+	return file_server2_tctxto2_proto_rawDescData
+}
+
+var file_server2_tctxto2_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_server2_tctxto2_proto_msgTypes = make([]protoimpl.MessageInfo, 140)
+var file_server2_tctxto2_proto_goTypes = []interface{}{
+	(NavigationPath)(0),                    // 0: server2.NavigationPath
+	(Mover)(0),                             // 1: server2.Mover
+	(Technicality)(0),                      // 2: server2.Technicality
+	(InvitationStatus)(0),                  // 3: server2.InvitationStatus
+	(LobbyMode)(0),                         // 4: server2.LobbyMode
+	(TournamentFormat)(0),                  // 5: server2.TournamentFormat
+	(TournamentStatus)(0),                  // 6: server2.TournamentStatus
+	(Presence)(0),                          // 7: server2.Presence
+	(AdminGameResult)(0),                   // 8: server2.AdminGameResult
+	(ForcedGameResult)(0),                  // 9: server2.ForcedGameResult
+	(AnnouncementSeverity)(0),              // 10: server2.AnnouncementSeverity
+	(*Empty)(nil),                          // 11: server2.Empty
+	(*ClientUpdate)(nil),                   // 12: server2.ClientUpdate
+	(*ServerUpdate)(nil),                   // 13: server2.ServerUpdate
+	(*Ping)(nil),                           // 14: server2.Ping
+	(*Lobby)(nil),                          // 15: server2.Lobby
+	(*Player)(nil),                         // 16: server2.Player
+	(*ClientAssignmentUpdate)(nil),         // 17: server2.ClientAssignmentUpdate
+	(*NavigationUpdate)(nil),               // 18: server2.NavigationUpdate
+	(*SignInRequest)(nil),                  // 19: server2.SignInRequest
+	(*SignInReply)(nil),                    // 20: server2.SignInReply
+	(*SignUpRequest)(nil),                  // 21: server2.SignUpRequest
+	(*SignUpReply)(nil),                    // 22: server2.SignUpReply
+	(*SignOutRequest)(nil),                 // 23: server2.SignOutRequest
+	(*SignOutReply)(nil),                   // 24: server2.SignOutReply
+	(*Outcome)(nil),                        // 25: server2.Outcome
+	(*MyLobbyDetails)(nil),                 // 26: server2.MyLobbyDetails
+	(*MyLobbyJoinerUpdate)(nil),            // 27: server2.MyLobbyJoinerUpdate
+	(*MyLobbyLeaverUpdate)(nil),            // 28: server2.MyLobbyLeaverUpdate
+	(*LeaveMyLobbyRequest)(nil),            // 29: server2.LeaveMyLobbyRequest
+	(*LeaveMyLobbyReply)(nil),              // 30: server2.LeaveMyLobbyReply
+	(*JoinLobbyRequest)(nil),               // 31: server2.JoinLobbyRequest
+	(*JoinLobbyReply)(nil),                 // 32: server2.JoinLobbyReply
+	(*CreateLobbyReply)(nil),               // 33: server2.CreateLobbyReply
+	(*Move)(nil),                           // 34: server2.Move
+	(*MoveUpdate)(nil),                     // 35: server2.MoveUpdate
+	(*NextMoverUpdate)(nil),                // 36: server2.NextMoverUpdate
+	(*MakeMoveRequest)(nil),                // 37: server2.MakeMoveRequest
+	(*MakeMoveReply)(nil),                  // 38: server2.MakeMoveReply
+	(*CreateLobbyRequest)(nil),             // 39: server2.CreateLobbyRequest
+	(*CreateGameRequest)(nil),              // 40: server2.CreateGameRequest
+	(*CreateGameReply)(nil),                // 41: server2.CreateGameReply
+	(*WinnerUpdate)(nil),                   // 42: server2.WinnerUpdate
+	(*DrawUpdate)(nil),                     // 43: server2.DrawUpdate
+	(*GameStartUpdate)(nil),                // 44: server2.GameStartUpdate
+	(*PlayerClientUpdate)(nil),             // 45: server2.PlayerClientUpdate
+	(*PlayerDisplayNameUpdate)(nil),        // 46: server2.PlayerDisplayNameUpdate
+	(*RematchRequest)(nil),                 // 47: server2.RematchRequest
+	(*RematchReply)(nil),                   // 48: server2.RematchReply
+	(*RematchDenied)(nil),                  // 49: server2.RematchDenied
+	(*RematchApproved)(nil),                // 50: server2.RematchApproved
+	(*RematchPending)(nil),                 // 51: server2.RematchPending
+	(*RematchCountdownUpdate)(nil),         // 52: server2.RematchCountdownUpdate
+	(*ChangePlayerDisplayNameRequest)(nil), // 53: server2.ChangePlayerDisplayNameRequest
+	(*ChangePlayerDisplayNameReply)(nil),   // 54: server2.ChangePlayerDisplayNameReply
+	(*LobbySearchRequest)(nil),             // 55: server2.LobbySearchRequest
+	(*LobbySearchReply)(nil),               // 56: server2.LobbySearchReply
+	(*LobbySearchResult)(nil),              // 57: server2.LobbySearchResult
+	(*InviteToLobbyRequest)(nil),           // 58: server2.InviteToLobbyRequest
+	(*InviteToLobbyReply)(nil),             // 59: server2.InviteToLobbyReply
+	(*LobbyInvitation)(nil),                // 60: server2.LobbyInvitation
+	(*LobbyInvitationUpdate)(nil),          // 61: server2.LobbyInvitationUpdate
+	(*RespondLobbyInvitationRequest)(nil),  // 62: server2.RespondLobbyInvitationRequest
+	(*RespondLobbyInvitationReply)(nil),    // 63: server2.RespondLobbyInvitationReply
+	(*LobbyInvitationResponseUpdate)(nil),  // 64: server2.LobbyInvitationResponseUpdate
+	(*SetReadyRequest)(nil),                // 65: server2.SetReadyRequest
+	(*SetReadyReply)(nil),                  // 66: server2.SetReadyReply
+	(*LobbyReadyStateUpdate)(nil),          // 67: server2.LobbyReadyStateUpdate
+	(*SetLobbyModeRequest)(nil),            // 68: server2.SetLobbyModeRequest
+	(*SetLobbyModeReply)(nil),              // 69: server2.SetLobbyModeReply
+	(*JoinPlayQueueRequest)(nil),           // 70: server2.JoinPlayQueueRequest
+	(*JoinPlayQueueReply)(nil),             // 71: server2.JoinPlayQueueReply
+	(*LeavePlayQueueRequest)(nil),          // 72: server2.LeavePlayQueueRequest
+	(*LeavePlayQueueReply)(nil),            // 73: server2.LeavePlayQueueReply
+	(*PlayQueueUpdate)(nil),                // 74: server2.PlayQueueUpdate
+	(*CreateTournamentRequest)(nil),        // 75: server2.CreateTournamentRequest
+	(*CreateTournamentReply)(nil),          // 76: server2.CreateTournamentReply
+	(*JoinTournamentRequest)(nil),          // 77: server2.JoinTournamentRequest
+	(*JoinTournamentReply)(nil),            // 78: server2.JoinTournamentReply
+	(*LeaveTournamentRequest)(nil),         // 79: server2.LeaveTournamentRequest
+	(*LeaveTournamentReply)(nil),           // 80: server2.LeaveTournamentReply
+	(*WatchTournamentRequest)(nil),         // 81: server2.WatchTournamentRequest
+	(*WatchTournamentReply)(nil),           // 82: server2.WatchTournamentReply
+	(*GetTournamentRequest)(nil),           // 83: server2.GetTournamentRequest
+	(*GetTournamentReply)(nil),             // 84: server2.GetTournamentReply
+	(*Tournament)(nil),                     // 85: server2.Tournament
+	(*TournamentMatch)(nil),                // 86: server2.TournamentMatch
+	(*TournamentStanding)(nil),             // 87: server2.TournamentStanding
+	(*TournamentBracketUpdate)(nil),        // 88: server2.TournamentBracketUpdate
+	(*SeriesScoreUpdate)(nil),              // 89: server2.SeriesScoreUpdate
+	(*AddFriendRequest)(nil),               // 90: server2.AddFriendRequest
+	(*AddFriendReply)(nil),                 // 91: server2.AddFriendReply
+	(*RemoveFriendRequest)(nil),            // 92: server2.RemoveFriendRequest
+	(*RemoveFriendReply)(nil),              // 93: server2.RemoveFriendReply
+	(*RespondFriendRequest)(nil),           // 94: server2.RespondFriendRequest
+	(*RespondFriendReply)(nil),             // 95: server2.RespondFriendReply
+	(*Friend)(nil),                         // 96: server2.Friend
+	(*FriendListUpdate)(nil),               // 97: server2.FriendListUpdate
+	(*FriendPresenceUpdate)(nil),           // 98: server2.FriendPresenceUpdate
+	(*ChallengePlayerRequest)(nil),         // 99: server2.ChallengePlayerRequest
+	(*ChallengePlayerReply)(nil),           // 100: server2.ChallengePlayerReply
+	(*Challenge)(nil),                      // 101: server2.Challenge
+	(*ChallengeReceivedUpdate)(nil),        // 102: server2.ChallengeReceivedUpdate
+	(*RespondChallengeRequest)(nil),        // 103: server2.RespondChallengeRequest
+	(*RespondChallengeReply)(nil),          // 104: server2.RespondChallengeReply
+	(*CancelChallengeRequest)(nil),         // 105: server2.CancelChallengeRequest
+	(*CancelChallengeReply)(nil),           // 106: server2.CancelChallengeReply
+	(*ChallengeResponseUpdate)(nil),        // 107: server2.ChallengeResponseUpdate
+	(*BlockPlayerRequest)(nil),             // 108: server2.BlockPlayerRequest
+	(*BlockPlayerReply)(nil),               // 109: server2.BlockPlayerReply
+	(*UnblockPlayerRequest)(nil),           // 110: server2.UnblockPlayerRequest
+	(*UnblockPlayerReply)(nil),             // 111: server2.UnblockPlayerReply
+	(*BlockListUpdate)(nil),                // 112: server2.BlockListUpdate
+	(*ChangePasswordRequest)(nil),          // 113: server2.ChangePasswordRequest
+	(*ChangePasswordReply)(nil),            // 114: server2.ChangePasswordReply
+	(*ChangeUsernameRequest)(nil),          // 115: server2.ChangeUsernameRequest
+	(*ChangeUsernameReply)(nil),            // 116: server2.ChangeUsernameReply
+	(*DeleteAccountRequest)(nil),           // 117: server2.DeleteAccountRequest
+	(*DeleteAccountReply)(nil),             // 118: server2.DeleteAccountReply
+	(*PlayerRenamedUpdate)(nil),            // 119: server2.PlayerRenamedUpdate
+	(*PlayAsGuestRequest)(nil),             // 120: server2.PlayAsGuestRequest
+	(*PlayAsGuestReply)(nil),               // 121: server2.PlayAsGuestReply
+	(*RateLimitUpdate)(nil),                // 122: server2.RateLimitUpdate
+	(*ListClientsRequest)(nil),             // 123: server2.ListClientsRequest
+	(*ListClientsReply)(nil),               // 124: server2.ListClientsReply
+	(*AdminClient)(nil),                    // 125: server2.AdminClient
+	(*ListPlayersRequest)(nil),             // 126: server2.ListPlayersRequest
+	(*ListPlayersReply)(nil),               // 127: server2.ListPlayersReply
+	(*AdminPlayer)(nil),                    // 128: server2.AdminPlayer
+	(*GetGameRequest)(nil),                 // 129: server2.GetGameRequest
+	(*AdminGame)(nil),                      // 130: server2.AdminGame
+	(*GetLobbyRequest)(nil),                // 131: server2.GetLobbyRequest
+	(*AdminLobby)(nil),                     // 132: server2.AdminLobby
+	(*EndGameRequest)(nil),                 // 133: server2.EndGameRequest
+	(*CloseLobbyRequest)(nil),              // 134: server2.CloseLobbyRequest
+	(*KickPlayerRequest)(nil),              // 135: server2.KickPlayerRequest
+	(*BanPlayerRequest)(nil),               // 136: server2.BanPlayerRequest
+	(*UnbanPlayerRequest)(nil),             // 137: server2.UnbanPlayerRequest
+	(*GetPlayerBansRequest)(nil),           // 138: server2.GetPlayerBansRequest
+	(*GetPlayerBansReply)(nil),             // 139: server2.GetPlayerBansReply
+	(*AdminBan)(nil),                       // 140: server2.AdminBan
+	(*BroadcastMessageRequest)(nil),        // 141: server2.BroadcastMessageRequest
+	(*BroadcastMessageReply)(nil),          // 142: server2.BroadcastMessageReply
+	(*SystemAnnouncementUpdate)(nil),       // 143: server2.SystemAnnouncementUpdate
+	(*Announcement)(nil),                   // 144: server2.Announcement
+	(*CreateAnnouncementRequest)(nil),      // 145: server2.CreateAnnouncementRequest
+	(*ListAnnouncementsRequest)(nil),       // 146: server2.ListAnnouncementsRequest
+	(*ListAnnouncementsReply)(nil),         // 147: server2.ListAnnouncementsReply
+	(*DeleteAnnouncementRequest)(nil),      // 148: server2.DeleteAnnouncementRequest
+	(*SetMaintenanceModeRequest)(nil),      // 149: server2.SetMaintenanceModeRequest
+	(*WebSocketAuth)(nil),                  // 150: server2.WebSocketAuth
+}
+var file_server2_tctxto2_proto_depIdxs = []int32{
+	21,  // 0: server2.ClientUpdate.sign_up_request:type_name -> server2.SignUpRequest
+	19,  // 1: server2.ClientUpdate.sign_in_request:type_name -> server2.SignInRequest
+	23,  // 2: server2.ClientUpdate.sign_out_request:type_name -> server2.SignOutRequest
+	39,  // 3: server2.ClientUpdate.create_lobby_request:type_name -> server2.CreateLobbyRequest
+	31,  // 4: server2.ClientUpdate.join_lobby_request:type_name -> server2.JoinLobbyRequest
+	29,  // 5: server2.ClientUpdate.leave_my_lobby_request:type_name -> server2.LeaveMyLobbyRequest
+	40,  // 6: server2.ClientUpdate.create_game_request:type_name -> server2.CreateGameRequest
+	37,  // 7: server2.ClientUpdate.make_move_request:type_name -> server2.MakeMoveRequest
+	47,  // 8: server2.ClientUpdate.rematch_request:type_name -> server2.RematchRequest
+	53,  // 9: server2.ClientUpdate.change_player_display_name_request:type_name -> server2.ChangePlayerDisplayNameRequest
+	55,  // 10: server2.ClientUpdate.lobby_search_request:type_name -> server2.LobbySearchRequest
+	58,  // 11: server2.ClientUpdate.invite_to_lobby_request:type_name -> server2.InviteToLobbyRequest
+	62,  // 12: server2.ClientUpdate.respond_lobby_invitation_request:type_name -> server2.RespondLobbyInvitationRequest
+	65,  // 13: server2.ClientUpdate.set_ready_request:type_name -> server2.SetReadyRequest
+	68,  // 14: server2.ClientUpdate.set_lobby_mode_request:type_name -> server2.SetLobbyModeRequest
+	70,  // 15: server2.ClientUpdate.join_play_queue_request:type_name -> server2.JoinPlayQueueRequest
+	72,  // 16: server2.ClientUpdate.leave_play_queue_request:type_name -> server2.LeavePlayQueueRequest
+	75,  // 17: server2.ClientUpdate.create_tournament_request:type_name -> server2.CreateTournamentRequest
+	77,  // 18: server2.ClientUpdate.join_tournament_request:type_name -> server2.JoinTournamentRequest
+	79,  // 19: server2.ClientUpdate.leave_tournament_request:type_name -> server2.LeaveTournamentRequest
+	81,  // 20: server2.ClientUpdate.watch_tournament_request:type_name -> server2.WatchTournamentRequest
+	83,  // 21: server2.ClientUpdate.get_tournament_request:type_name -> server2.GetTournamentRequest
+	90,  // 22: server2.ClientUpdate.add_friend_request:type_name -> server2.AddFriendRequest
+	92,  // 23: server2.ClientUpdate.remove_friend_request:type_name -> server2.RemoveFriendRequest
+	94,  // 24: server2.ClientUpdate.respond_friend_request:type_name -> server2.RespondFriendRequest
+	99,  // 25: server2.ClientUpdate.challenge_player_request:type_name -> server2.ChallengePlayerRequest
+	103, // 26: server2.ClientUpdate.respond_challenge_request:type_name -> server2.RespondChallengeRequest
+	105, // 27: server2.ClientUpdate.cancel_challenge_request:type_name -> server2.CancelChallengeRequest
+	108, // 28: server2.ClientUpdate.block_player_request:type_name -> server2.BlockPlayerRequest
+	110, // 29: server2.ClientUpdate.unblock_player_request:type_name -> server2.UnblockPlayerRequest
+	113, // 30: server2.ClientUpdate.change_password_request:type_name -> server2.ChangePasswordRequest
+	115, // 31: server2.ClientUpdate.change_username_request:type_name -> server2.ChangeUsernameRequest
+	117, // 32: server2.ClientUpdate.delete_account_request:type_name -> server2.DeleteAccountRequest
+	120, // 33: server2.ClientUpdate.play_as_guest_request:type_name -> server2.PlayAsGuestRequest
+	14,  // 34: server2.ServerUpdate.ping:type_name -> server2.Ping
+	17,  // 35: server2.ServerUpdate.client_assignment_update:type_name -> server2.ClientAssignmentUpdate
+	18,  // 36: server2.ServerUpdate.navigation_update:type_name -> server2.NavigationUpdate
+	22,  // 37: server2.ServerUpdate.sign_up_reply:type_name -> server2.SignUpReply
+	20,  // 38: server2.ServerUpdate.sign_in_reply:type_name -> server2.SignInReply
+	24,  // 39: server2.ServerUpdate.sign_out_reply:type_name -> server2.SignOutReply
+	26,  // 40: server2.ServerUpdate.my_lobby_details:type_name -> server2.MyLobbyDetails
+	27,  // 41: server2.ServerUpdate.my_lobby_joiner_update:type_name -> server2.MyLobbyJoinerUpdate
+	28,  // 42: server2.ServerUpdate.my_lobby_leaver_update:type_name -> server2.MyLobbyLeaverUpdate
+	33,  // 43: server2.ServerUpdate.create_lobby_reply:type_name -> server2.CreateLobbyReply
+	32,  // 44: server2.ServerUpdate.join_lobby_reply:type_name -> server2.JoinLobbyReply
+	30,  // 45: server2.ServerUpdate.leave_my_lobby_reply:type_name -> server2.LeaveMyLobbyReply
+	41,  // 46: server2.ServerUpdate.create_game_reply:type_name -> server2.CreateGameReply
+	38,  // 47: server2.ServerUpdate.make_move_reply:type_name -> server2.MakeMoveReply
+	35,  // 48: server2.ServerUpdate.move_update:type_name -> server2.MoveUpdate
+	42,  // 49: server2.ServerUpdate.winner_update:type_name -> server2.WinnerUpdate
+	43,  // 50: server2.ServerUpdate.draw_update:type_name -> server2.DrawUpdate
+	44,  // 51: server2.ServerUpdate.game_start_update:type_name -> server2.GameStartUpdate
+	36,  // 52: server2.ServerUpdate.next_mover_update:type_name -> server2.NextMoverUpdate
+	45,  // 53: server2.ServerUpdate.player_client_update:type_name -> server2.PlayerClientUpdate
+	46,  // 54: server2.ServerUpdate.player_display_name_update:type_name -> server2.PlayerDisplayNameUpdate
+	48,  // 55: server2.ServerUpdate.rematch_reply:type_name -> server2.RematchReply
+	49,  // 56: server2.ServerUpdate.rematch_denied:type_name -> server2.RematchDenied
+	50,  // 57: server2.ServerUpdate.rematch_approved:type_name -> server2.RematchApproved
+	51,  // 58: server2.ServerUpdate.rematch_pending:type_name -> server2.RematchPending
+	54,  // 59: server2.ServerUpdate.change_player_display_name_reply:type_name -> server2.ChangePlayerDisplayNameReply
+	56,  // 60: server2.ServerUpdate.lobby_search_reply:type_name -> server2.LobbySearchReply
+	57,  // 61: server2.ServerUpdate.lobby_search_result:type_name -> server2.LobbySearchResult
+	59,  // 62: server2.ServerUpdate.invite_to_lobby_reply:type_name -> server2.InviteToLobbyReply
+	61,  // 63: server2.ServerUpdate.lobby_invitation_update:type_name -> server2.LobbyInvitationUpdate
+	63,  // 64: server2.ServerUpdate.respond_lobby_invitation_reply:type_name -> server2.RespondLobbyInvitationReply
+	64,  // 65: server2.ServerUpdate.lobby_invitation_response_update:type_name -> server2.LobbyInvitationResponseUpdate
+	66,  // 66: server2.ServerUpdate.set_ready_reply:type_name -> server2.SetReadyReply
+	67,  // 67: server2.ServerUpdate.lobby_ready_state_update:type_name -> server2.LobbyReadyStateUpdate
+	69,  // 68: server2.ServerUpdate.set_lobby_mode_reply:type_name -> server2.SetLobbyModeReply
+	71,  // 69: server2.ServerUpdate.join_play_queue_reply:type_name -> server2.JoinPlayQueueReply
+	73,  // 70: server2.ServerUpdate.leave_play_queue_reply:type_name -> server2.LeavePlayQueueReply
+	74,  // 71: server2.ServerUpdate.play_queue_update:type_name -> server2.PlayQueueUpdate
+	76,  // 72: server2.ServerUpdate.create_tournament_reply:type_name -> server2.CreateTournamentReply
+	78,  // 73: server2.ServerUpdate.join_tournament_reply:type_name -> server2.JoinTournamentReply
+	80,  // 74: server2.ServerUpdate.leave_tournament_reply:type_name -> server2.LeaveTournamentReply
+	82,  // 75: server2.ServerUpdate.watch_tournament_reply:type_name -> server2.WatchTournamentReply
+	84,  // 76: server2.ServerUpdate.get_tournament_reply:type_name -> server2.GetTournamentReply
+	88,  // 77: server2.ServerUpdate.tournament_bracket_update:type_name -> server2.TournamentBracketUpdate
+	89,  // 78: server2.ServerUpdate.series_score_update:type_name -> server2.SeriesScoreUpdate
+	52,  // 79: server2.ServerUpdate.rematch_countdown_update:type_name -> server2.RematchCountdownUpdate
+	91,  // 80: server2.ServerUpdate.add_friend_reply:type_name -> server2.AddFriendReply
+	93,  // 81: server2.ServerUpdate.remove_friend_reply:type_name -> server2.RemoveFriendReply
+	95,  // 82: server2.ServerUpdate.respond_friend_reply:type_name -> server2.RespondFriendReply
+	97,  // 83: server2.ServerUpdate.friend_list_update:type_name -> server2.FriendListUpdate
+	98,  // 84: server2.ServerUpdate.friend_presence_update:type_name -> server2.FriendPresenceUpdate
+	100, // 85: server2.ServerUpdate.challenge_player_reply:type_name -> server2.ChallengePlayerReply
+	102, // 86: server2.ServerUpdate.challenge_received_update:type_name -> server2.ChallengeReceivedUpdate
+	104, // 87: server2.ServerUpdate.respond_challenge_reply:type_name -> server2.RespondChallengeReply
+	106, // 88: server2.ServerUpdate.cancel_challenge_reply:type_name -> server2.CancelChallengeReply
+	107, // 89: server2.ServerUpdate.challenge_response_update:type_name -> server2.ChallengeResponseUpdate
+	109, // 90: server2.ServerUpdate.block_player_reply:type_name -> server2.BlockPlayerReply
+	111, // 91: server2.ServerUpdate.unblock_player_reply:type_name -> server2.UnblockPlayerReply
+	112, // 92: server2.ServerUpdate.block_list_update:type_name -> server2.BlockListUpdate
+	114, // 93: server2.ServerUpdate.change_password_reply:type_name -> server2.ChangePasswordReply
+	116, // 94: server2.ServerUpdate.change_username_reply:type_name -> server2.ChangeUsernameReply
+	118, // 95: server2.ServerUpdate.delete_account_reply:type_name -> server2.DeleteAccountReply
+	119, // 96: server2.ServerUpdate.player_renamed_update:type_name -> server2.PlayerRenamedUpdate
+	121, // 97: server2.ServerUpdate.play_as_guest_reply:type_name -> server2.PlayAsGuestReply
+	122, // 98: server2.ServerUpdate.rate_limit_update:type_name -> server2.RateLimitUpdate
+	143, // 99: server2.ServerUpdate.system_announcement_update:type_name -> server2.SystemAnnouncementUpdate
+	16,  // 100: server2.Lobby.players:type_name -> server2.Player
+	4,   // 101: server2.Lobby.mode:type_name -> server2.LobbyMode
+	0,   // 102: server2.NavigationUpdate.path:type_name -> server2.NavigationPath
+	25,  // 103: server2.SignInReply.Outcome:type_name -> server2.Outcome
+	25,  // 104: server2.SignUpReply.outcome:type_name -> server2.Outcome
+	25,  // 105: server2.SignOutReply.outcome:type_name -> server2.Outcome
+	15,  // 106: server2.MyLobbyDetails.lobby:type_name -> server2.Lobby
+	16,  // 107: server2.MyLobbyJoinerUpdate.player:type_name -> server2.Player
+	16,  // 108: server2.MyLobbyLeaverUpdate.player:type_name -> server2.Player
+	25,  // 109: server2.LeaveMyLobbyReply.outcome:type_name -> server2.Outcome
+	25,  // 110: server2.JoinLobbyReply.outcome:type_name -> server2.Outcome
+	25,  // 111: server2.CreateLobbyReply.outcome:type_name -> server2.Outcome
+	1,   // 112: server2.Move.mover:type_name -> server2.Mover
+	34,  // 113: server2.MoveUpdate.move:type_name -> server2.Move
+	25,  // 114: server2.MakeMoveReply.outcome:type_name -> server2.Outcome
+	25,  // 115: server2.CreateGameReply.outcome:type_name -> server2.Outcome
+	2,   // 116: server2.WinnerUpdate.technicality:type_name -> server2.Technicality
+	1,   // 117: server2.GameStartUpdate.you:type_name -> server2.Mover
+	25,  // 118: server2.RematchReply.outcome:type_name -> server2.Outcome
+	25,  // 119: server2.ChangePlayerDisplayNameReply.outcome:type_name -> server2.Outcome
+	25,  // 120: server2.LobbySearchReply.outcome:type_name -> server2.Outcome
+	15,  // 121: server2.LobbySearchResult.lobbies:type_name -> server2.Lobby
+	25,  // 122: server2.InviteToLobbyReply.outcome:type_name -> server2.Outcome
+	15,  // 123: server2.LobbyInvitation.lobby:type_name -> server2.Lobby
+	16,  // 124: server2.LobbyInvitation.inviter:type_name -> server2.Player
+	60,  // 125: server2.LobbyInvitationUpdate.invitation:type_name -> server2.LobbyInvitation
+	25,  // 126: server2.RespondLobbyInvitationReply.outcome:type_name -> server2.Outcome
+	16,  // 127: server2.LobbyInvitationResponseUpdate.invitee:type_name -> server2.Player
+	3,   // 128: server2.LobbyInvitationResponseUpdate.status:type_name -> server2.InvitationStatus
+	25,  // 129: server2.SetReadyReply.outcome:type_name -> server2.Outcome
+	4,   // 130: server2.SetLobbyModeRequest.mode:type_name -> server2.LobbyMode
+	25,  // 131: server2.SetLobbyModeReply.outcome:type_name -> server2.Outcome
+	25,  // 132: server2.JoinPlayQueueReply.outcome:type_name -> server2.Outcome
+	25,  // 133: server2.LeavePlayQueueReply.outcome:type_name -> server2.Outcome
+	4,   // 134: server2.PlayQueueUpdate.mode:type_name -> server2.LobbyMode
+	5,   // 135: server2.CreateTournamentRequest.format:type_name -> server2.TournamentFormat
+	25,  // 136: server2.CreateTournamentReply.outcome:type_name -> server2.Outcome
+	25,  // 137: server2.JoinTournamentReply.outcome:type_name -> server2.Outcome
+	25,  // 138: server2.LeaveTournamentReply.outcome:type_name -> server2.Outcome
+	25,  // 139: server2.WatchTournamentReply.outcome:type_name -> server2.Outcome
+	25,  // 140: server2.GetTournamentReply.outcome:type_name -> server2.Outcome
+	5,   // 141: server2.Tournament.format:type_name -> server2.TournamentFormat
+	6,   // 142: server2.Tournament.status:type_name -> server2.TournamentStatus
+	16,  // 143: server2.Tournament.entrants:type_name -> server2.Player
+	86,  // 144: server2.Tournament.matches:type_name -> server2.TournamentMatch
+	87,  // 145: server2.Tournament.standings:type_name -> server2.TournamentStanding
+	16,  // 146: server2.TournamentMatch.player1:type_name -> server2.Player
+	16,  // 147: server2.TournamentMatch.player2:type_name -> server2.Player
+	16,  // 148: server2.TournamentStanding.player:type_name -> server2.Player
+	85,  // 149: server2.TournamentBracketUpdate.tournament:type_name -> server2.Tournament
+	25,  // 150: server2.SeriesScoreUpdate.outcome:type_name -> server2.Outcome
+	25,  // 151: server2.AddFriendReply.outcome:type_name -> server2.Outcome
+	25,  // 152: server2.RemoveFriendReply.outcome:type_name -> server2.Outcome
+	25,  // 153: server2.RespondFriendReply.outcome:type_name -> server2.Outcome
+	16,  // 154: server2.Friend.player:type_name -> server2.Player
+	7,   // 155: server2.Friend.presence:type_name -> server2.Presence
+	96,  // 156: server2.FriendListUpdate.friends:type_name -> server2.Friend
+	16,  // 157: server2.FriendListUpdate.incoming_requests:type_name -> server2.Player
+	16,  // 158: server2.FriendListUpdate.outgoing_requests:type_name -> server2.Player
+	96,  // 159: server2.FriendPresenceUpdate.friend:type_name -> server2.Friend
+	25,  // 160: server2.ChallengePlayerReply.outcome:type_name -> server2.Outcome
+	16,  // 161: server2.Challenge.challenger:type_name -> server2.Player
+	16,  // 162: server2.Challenge.challengee:type_name -> server2.Player
+	101, // 163: server2.ChallengeReceivedUpdate.challenge:type_name -> server2.Challenge
+	25,  // 164: server2.RespondChallengeReply.outcome:type_name -> server2.Outcome
+	25,  // 165: server2.CancelChallengeReply.outcome:type_name -> server2.Outcome
+	101, // 166: server2.ChallengeResponseUpdate.challenge:type_name -> server2.Challenge
+	3,   // 167: server2.ChallengeResponseUpdate.status:type_name -> server2.InvitationStatus
+	25,  // 168: server2.BlockPlayerReply.outcome:type_name -> server2.Outcome
+	25,  // 169: server2.UnblockPlayerReply.outcome:type_name -> server2.Outcome
+	16,  // 170: server2.BlockListUpdate.players:type_name -> server2.Player
+	25,  // 171: server2.ChangePasswordReply.outcome:type_name -> server2.Outcome
+	25,  // 172: server2.ChangeUsernameReply.outcome:type_name -> server2.Outcome
+	25,  // 173: server2.DeleteAccountReply.outcome:type_name -> server2.Outcome
+	16,  // 174: server2.PlayerRenamedUpdate.player:type_name -> server2.Player
+	25,  // 175: server2.PlayAsGuestReply.outcome:type_name -> server2.Outcome
+	25,  // 176: server2.RateLimitUpdate.outcome:type_name -> server2.Outcome
+	125, // 177: server2.ListClientsReply.clients:type_name -> server2.AdminClient
+	128, // 178: server2.ListPlayersReply.players:type_name -> server2.AdminPlayer
+	7,   // 179: server2.AdminPlayer.presence:type_name -> server2.Presence
+	8,   // 180: server2.AdminGame.result:type_name -> server2.AdminGameResult
+	4,   // 181: server2.AdminLobby.mode:type_name -> server2.LobbyMode
+	9,   // 182: server2.EndGameRequest.result:type_name -> server2.ForcedGameResult
+	140, // 183: server2.GetPlayerBansReply.bans:type_name -> server2.AdminBan
+	144, // 184: server2.SystemAnnouncementUpdate.announcement:type_name -> server2.Announcement
+	10,  // 185: server2.Announcement.severity:type_name -> server2.AnnouncementSeverity
+	10,  // 186: server2.CreateAnnouncementRequest.severity:type_name -> server2.AnnouncementSeverity
+	144, // 187: server2.ListAnnouncementsReply.announcements:type_name -> server2.Announcement
+	11,  // 188: server2.TicTacToe.Subscribe:input_type -> server2.Empty
+	12,  // 189: server2.TicTacToe.Notify:input_type -> server2.ClientUpdate
+	12,  // 190: server2.TicTacToe.SubscribeBiDir:input_type -> server2.ClientUpdate
+	123, // 191: server2.TicTacToeAdmin.ListClients:input_type -> server2.ListClientsRequest
+	126, // 192: server2.TicTacToeAdmin.ListPlayers:input_type -> server2.ListPlayersRequest
+	129, // 193: server2.TicTacToeAdmin.GetGame:input_type -> server2.GetGameRequest
+	131, // 194: server2.TicTacToeAdmin.GetLobby:input_type -> server2.GetLobbyRequest
+	133, // 195: server2.TicTacToeAdmin.EndGame:input_type -> server2.EndGameRequest
+	134, // 196: server2.TicTacToeAdmin.CloseLobby:input_type -> server2.CloseLobbyRequest
+	135, // 197: server2.TicTacToeAdmin.KickPlayer:input_type -> server2.KickPlayerRequest
+	136, // 198: server2.TicTacToeAdmin.BanPlayer:input_type -> server2.BanPlayerRequest
+	137, // 199: server2.TicTacToeAdmin.UnbanPlayer:input_type -> server2.UnbanPlayerRequest
+	138, // 200: server2.TicTacToeAdmin.GetPlayerBans:input_type -> server2.GetPlayerBansRequest
+	141, // 201: server2.TicTacToeAdmin.BroadcastMessage:input_type -> server2.BroadcastMessageRequest
+	145, // 202: server2.TicTacToeAdmin.CreateAnnouncement:input_type -> server2.CreateAnnouncementRequest
+	146, // 203: server2.TicTacToeAdmin.ListAnnouncements:input_type -> server2.ListAnnouncementsRequest
+	148, // 204: server2.TicTacToeAdmin.DeleteAnnouncement:input_type -> server2.DeleteAnnouncementRequest
+	149, // 205: server2.TicTacToeAdmin.SetMaintenanceMode:input_type -> server2.SetMaintenanceModeRequest
+	13,  // 206: server2.TicTacToe.Subscribe:output_type -> server2.ServerUpdate
+	11,  // 207: server2.TicTacToe.Notify:output_type -> server2.Empty
+	13,  // 208: server2.TicTacToe.SubscribeBiDir:output_type -> server2.ServerUpdate
+	124, // 209: server2.TicTacToeAdmin.ListClients:output_type -> server2.ListClientsReply
+	127, // 210: server2.TicTacToeAdmin.ListPlayers:output_type -> server2.ListPlayersReply
+	130, // 211: server2.TicTacToeAdmin.GetGame:output_type -> server2.AdminGame
+	132, // 212: server2.TicTacToeAdmin.GetLobby:output_type -> server2.AdminLobby
+	11,  // 213: server2.TicTacToeAdmin.EndGame:output_type -> server2.Empty
+	11,  // 214: server2.TicTacToeAdmin.CloseLobby:output_type -> server2.Empty
+	11,  // 215: server2.TicTacToeAdmin.KickPlayer:output_type -> server2.Empty
+	11,  // 216: server2.TicTacToeAdmin.BanPlayer:output_type -> server2.Empty
+	11,  // 217: server2.TicTacToeAdmin.UnbanPlayer:output_type -> server2.Empty
+	139, // 218: server2.TicTacToeAdmin.GetPlayerBans:output_type -> server2.GetPlayerBansReply
+	142, // 219: server2.TicTacToeAdmin.BroadcastMessage:output_type -> server2.BroadcastMessageReply
+	144, // 220: server2.TicTacToeAdmin.CreateAnnouncement:output_type -> server2.Announcement
+	147, // 221: server2.TicTacToeAdmin.ListAnnouncements:output_type -> server2.ListAnnouncementsReply
+	11,  // 222: server2.TicTacToeAdmin.DeleteAnnouncement:output_type -> server2.Empty
+	11,  // 223: server2.TicTacToeAdmin.SetMaintenanceMode:output_type -> server2.Empty
+	206, // [206:224] is the sub-list for method output_type
+	188, // [188:206] is the sub-list for method input_type
+	188, // [188:188] is the sub-list for extension type_name
+	188, // [188:188] is the sub-list for extension extendee
+	0,   // [0:188] is the sub-list for field type_name
+}
+
+func init() { file_server2_tctxto2_proto_init() }
+func file_server2_tctxto2_proto_init() {
+	if File_server2_tctxto2_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_server2_tctxto2_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Lobby); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Player); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientAssignmentUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NavigationUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignInRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignInReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignUpRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignUpReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignOutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignOutReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Outcome); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MyLobbyDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MyLobbyJoinerUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MyLobbyLeaverUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveMyLobbyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveMyLobbyReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinLobbyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinLobbyReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLobbyReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Move); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NextMoverUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MakeMoveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MakeMoveReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLobbyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGameReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WinnerUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameStartUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerClientUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerDisplayNameUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RematchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RematchReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RematchDenied); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RematchApproved); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RematchPending); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RematchCountdownUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangePlayerDisplayNameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangePlayerDisplayNameReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LobbySearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LobbySearchReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LobbySearchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteToLobbyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteToLobbyReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LobbyInvitation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LobbyInvitationUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondLobbyInvitationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondLobbyInvitationReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LobbyInvitationResponseUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetReadyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetReadyReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LobbyReadyStateUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLobbyModeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLobbyModeReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinPlayQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinPlayQueueReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeavePlayQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeavePlayQueueReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayQueueUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTournamentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTournamentReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinTournamentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinTournamentReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveTournamentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveTournamentReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchTournamentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchTournamentReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTournamentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTournamentReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tournament); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TournamentMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TournamentStanding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TournamentBracketUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SeriesScoreUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFriendRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFriendReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveFriendRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveFriendReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondFriendRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondFriendReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Friend); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FriendListUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FriendPresenceUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChallengePlayerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChallengePlayerReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Challenge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChallengeReceivedUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3407,8 +12257,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClientUpdate); i {
+		file_server2_tctxto2_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondChallengeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3419,8 +12269,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerUpdate); i {
+		file_server2_tctxto2_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondChallengeReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3431,8 +12281,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Ping); i {
+		file_server2_tctxto2_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelChallengeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3443,8 +12293,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Lobby); i {
+		file_server2_tctxto2_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelChallengeReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3455,8 +12305,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Player); i {
+		file_server2_tctxto2_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChallengeResponseUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3467,8 +12317,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClientAssignmentUpdate); i {
+		file_server2_tctxto2_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockPlayerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3479,8 +12329,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NavigationUpdate); i {
+		file_server2_tctxto2_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockPlayerReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3491,8 +12341,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignInRequest); i {
+		file_server2_tctxto2_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnblockPlayerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3503,8 +12353,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignInReply); i {
+		file_server2_tctxto2_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnblockPlayerReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3515,8 +12365,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignUpRequest); i {
+		file_server2_tctxto2_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockListUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3527,8 +12377,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignUpReply); i {
+		file_server2_tctxto2_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangePasswordRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3539,8 +12389,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignOutRequest); i {
+		file_server2_tctxto2_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangePasswordReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3551,8 +12401,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignOutReply); i {
+		file_server2_tctxto2_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeUsernameRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3563,8 +12413,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Outcome); i {
+		file_server2_tctxto2_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeUsernameReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3575,8 +12425,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MyLobbyDetails); i {
+		file_server2_tctxto2_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAccountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3587,8 +12437,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MyLobbyJoinerUpdate); i {
+		file_server2_tctxto2_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAccountReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3599,8 +12449,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MyLobbyLeaverUpdate); i {
+		file_server2_tctxto2_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerRenamedUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3611,8 +12461,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeaveMyLobbyRequest); i {
+		file_server2_tctxto2_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayAsGuestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3623,8 +12473,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeaveMyLobbyReply); i {
+		file_server2_tctxto2_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayAsGuestReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3635,8 +12485,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinLobbyRequest); i {
+		file_server2_tctxto2_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateLimitUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3647,8 +12497,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinLobbyReply); i {
+		file_server2_tctxto2_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListClientsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3659,8 +12509,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateLobbyReply); i {
+		file_server2_tctxto2_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListClientsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3671,8 +12521,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Move); i {
+		file_server2_tctxto2_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminClient); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3683,8 +12533,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MoveUpdate); i {
+		file_server2_tctxto2_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPlayersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3695,8 +12545,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NextMoverUpdate); i {
+		file_server2_tctxto2_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPlayersReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3707,8 +12557,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MakeMoveRequest); i {
+		file_server2_tctxto2_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminPlayer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3719,8 +12569,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MakeMoveReply); i {
+		file_server2_tctxto2_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGameRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3731,8 +12581,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateLobbyRequest); i {
+		file_server2_tctxto2_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminGame); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3743,8 +12593,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateGameRequest); i {
+		file_server2_tctxto2_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLobbyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3755,8 +12605,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateGameReply); i {
+		file_server2_tctxto2_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLobby); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3767,8 +12617,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WinnerUpdate); i {
+		file_server2_tctxto2_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndGameRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3779,8 +12629,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DrawUpdate); i {
+		file_server2_tctxto2_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseLobbyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3791,8 +12641,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GameStartUpdate); i {
+		file_server2_tctxto2_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickPlayerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3803,8 +12653,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayerClientUpdate); i {
+		file_server2_tctxto2_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BanPlayerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3815,8 +12665,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayerDisplayNameUpdate); i {
+		file_server2_tctxto2_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnbanPlayerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3827,8 +12677,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RematchRequest); i {
+		file_server2_tctxto2_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerBansRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3839,8 +12689,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RematchReply); i {
+		file_server2_tctxto2_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerBansReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3851,8 +12701,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RematchDenied); i {
+		file_server2_tctxto2_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminBan); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3863,8 +12713,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RematchApproved); i {
+		file_server2_tctxto2_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastMessageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3875,8 +12725,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RematchPending); i {
+		file_server2_tctxto2_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastMessageReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3887,8 +12737,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangePlayerDisplayNameRequest); i {
+		file_server2_tctxto2_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemAnnouncementUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3899,8 +12749,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangePlayerDisplayNameReply); i {
+		file_server2_tctxto2_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Announcement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3911,8 +12761,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LobbySearchRequest); i {
+		file_server2_tctxto2_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAnnouncementRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3923,8 +12773,8 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LobbySearchReply); i {
+		file_server2_tctxto2_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAnnouncementsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3935,8 +12785,44 @@ func file_server2_tctxto2_proto_init() {
 				return nil
 			}
 		}
-		file_server2_tctxto2_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LobbySearchResult); i {
+		file_server2_tctxto2_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAnnouncementsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAnnouncementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMaintenanceModeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server2_tctxto2_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebSocketAuth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3960,6 +12846,29 @@ func file_server2_tctxto2_proto_init() {
 		(*ClientUpdate_RematchRequest)(nil),
 		(*ClientUpdate_ChangePlayerDisplayNameRequest)(nil),
 		(*ClientUpdate_LobbySearchRequest)(nil),
+		(*ClientUpdate_InviteToLobbyRequest)(nil),
+		(*ClientUpdate_RespondLobbyInvitationRequest)(nil),
+		(*ClientUpdate_SetReadyRequest)(nil),
+		(*ClientUpdate_SetLobbyModeRequest)(nil),
+		(*ClientUpdate_JoinPlayQueueRequest)(nil),
+		(*ClientUpdate_LeavePlayQueueRequest)(nil),
+		(*ClientUpdate_CreateTournamentRequest)(nil),
+		(*ClientUpdate_JoinTournamentRequest)(nil),
+		(*ClientUpdate_LeaveTournamentRequest)(nil),
+		(*ClientUpdate_WatchTournamentRequest)(nil),
+		(*ClientUpdate_GetTournamentRequest)(nil),
+		(*ClientUpdate_AddFriendRequest)(nil),
+		(*ClientUpdate_RemoveFriendRequest)(nil),
+		(*ClientUpdate_RespondFriendRequest)(nil),
+		(*ClientUpdate_ChallengePlayerRequest)(nil),
+		(*ClientUpdate_RespondChallengeRequest)(nil),
+		(*ClientUpdate_CancelChallengeRequest)(nil),
+		(*ClientUpdate_BlockPlayerRequest)(nil),
+		(*ClientUpdate_UnblockPlayerRequest)(nil),
+		(*ClientUpdate_ChangePasswordRequest)(nil),
+		(*ClientUpdate_ChangeUsernameRequest)(nil),
+		(*ClientUpdate_DeleteAccountRequest)(nil),
+		(*ClientUpdate_PlayAsGuestRequest)(nil),
 	}
 	file_server2_tctxto2_proto_msgTypes[2].OneofWrappers = []interface{}{
 		(*ServerUpdate_Ping)(nil),
@@ -3990,16 +12899,54 @@ func file_server2_tctxto2_proto_init() {
 		(*ServerUpdate_ChangePlayerDisplayNameReply)(nil),
 		(*ServerUpdate_LobbySearchReply)(nil),
 		(*ServerUpdate_LobbySearchResult)(nil),
+		(*ServerUpdate_InviteToLobbyReply)(nil),
+		(*ServerUpdate_LobbyInvitationUpdate)(nil),
+		(*ServerUpdate_RespondLobbyInvitationReply)(nil),
+		(*ServerUpdate_LobbyInvitationResponseUpdate)(nil),
+		(*ServerUpdate_SetReadyReply)(nil),
+		(*ServerUpdate_LobbyReadyStateUpdate)(nil),
+		(*ServerUpdate_SetLobbyModeReply)(nil),
+		(*ServerUpdate_JoinPlayQueueReply)(nil),
+		(*ServerUpdate_LeavePlayQueueReply)(nil),
+		(*ServerUpdate_PlayQueueUpdate)(nil),
+		(*ServerUpdate_CreateTournamentReply)(nil),
+		(*ServerUpdate_JoinTournamentReply)(nil),
+		(*ServerUpdate_LeaveTournamentReply)(nil),
+		(*ServerUpdate_WatchTournamentReply)(nil),
+		(*ServerUpdate_GetTournamentReply)(nil),
+		(*ServerUpdate_TournamentBracketUpdate)(nil),
+		(*ServerUpdate_SeriesScoreUpdate)(nil),
+		(*ServerUpdate_RematchCountdownUpdate)(nil),
+		(*ServerUpdate_AddFriendReply)(nil),
+		(*ServerUpdate_RemoveFriendReply)(nil),
+		(*ServerUpdate_RespondFriendReply)(nil),
+		(*ServerUpdate_FriendListUpdate)(nil),
+		(*ServerUpdate_FriendPresenceUpdate)(nil),
+		(*ServerUpdate_ChallengePlayerReply)(nil),
+		(*ServerUpdate_ChallengeReceivedUpdate)(nil),
+		(*ServerUpdate_RespondChallengeReply)(nil),
+		(*ServerUpdate_CancelChallengeReply)(nil),
+		(*ServerUpdate_ChallengeResponseUpdate)(nil),
+		(*ServerUpdate_BlockPlayerReply)(nil),
+		(*ServerUpdate_UnblockPlayerReply)(nil),
+		(*ServerUpdate_BlockListUpdate)(nil),
+		(*ServerUpdate_ChangePasswordReply)(nil),
+		(*ServerUpdate_ChangeUsernameReply)(nil),
+		(*ServerUpdate_DeleteAccountReply)(nil),
+		(*ServerUpdate_PlayerRenamedUpdate)(nil),
+		(*ServerUpdate_PlayAsGuestReply)(nil),
+		(*ServerUpdate_RateLimitUpdate)(nil),
+		(*ServerUpdate_SystemAnnouncementUpdate)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_server2_tctxto2_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   46,
+			NumEnums:      11,
+			NumMessages:   140,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_server2_tctxto2_proto_goTypes,
 		DependencyIndexes: file_server2_tctxto2_proto_depIdxs,
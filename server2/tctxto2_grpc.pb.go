@@ -231,3 +231,593 @@ var TicTacToe_ServiceDesc = grpc.ServiceDesc{
 	},
 	Metadata: "server2/tctxto2.proto",
 }
+
+// TicTacToeAdminClient is the client API for TicTacToeAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TicTacToeAdminClient interface {
+	ListClients(ctx context.Context, in *ListClientsRequest, opts ...grpc.CallOption) (*ListClientsReply, error)
+	ListPlayers(ctx context.Context, in *ListPlayersRequest, opts ...grpc.CallOption) (*ListPlayersReply, error)
+	GetGame(ctx context.Context, in *GetGameRequest, opts ...grpc.CallOption) (*AdminGame, error)
+	GetLobby(ctx context.Context, in *GetLobbyRequest, opts ...grpc.CallOption) (*AdminLobby, error)
+	EndGame(ctx context.Context, in *EndGameRequest, opts ...grpc.CallOption) (*Empty, error)
+	CloseLobby(ctx context.Context, in *CloseLobbyRequest, opts ...grpc.CallOption) (*Empty, error)
+	KickPlayer(ctx context.Context, in *KickPlayerRequest, opts ...grpc.CallOption) (*Empty, error)
+	BanPlayer(ctx context.Context, in *BanPlayerRequest, opts ...grpc.CallOption) (*Empty, error)
+	UnbanPlayer(ctx context.Context, in *UnbanPlayerRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetPlayerBans(ctx context.Context, in *GetPlayerBansRequest, opts ...grpc.CallOption) (*GetPlayerBansReply, error)
+	BroadcastMessage(ctx context.Context, in *BroadcastMessageRequest, opts ...grpc.CallOption) (*BroadcastMessageReply, error)
+	CreateAnnouncement(ctx context.Context, in *CreateAnnouncementRequest, opts ...grpc.CallOption) (*Announcement, error)
+	ListAnnouncements(ctx context.Context, in *ListAnnouncementsRequest, opts ...grpc.CallOption) (*ListAnnouncementsReply, error)
+	DeleteAnnouncement(ctx context.Context, in *DeleteAnnouncementRequest, opts ...grpc.CallOption) (*Empty, error)
+	SetMaintenanceMode(ctx context.Context, in *SetMaintenanceModeRequest, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type ticTacToeAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTicTacToeAdminClient(cc grpc.ClientConnInterface) TicTacToeAdminClient {
+	return &ticTacToeAdminClient{cc}
+}
+
+func (c *ticTacToeAdminClient) ListClients(ctx context.Context, in *ListClientsRequest, opts ...grpc.CallOption) (*ListClientsReply, error) {
+	out := new(ListClientsReply)
+	err := c.cc.Invoke(ctx, "/server2.TicTacToeAdmin/ListClients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeAdminClient) ListPlayers(ctx context.Context, in *ListPlayersRequest, opts ...grpc.CallOption) (*ListPlayersReply, error) {
+	out := new(ListPlayersReply)
+	err := c.cc.Invoke(ctx, "/server2.TicTacToeAdmin/ListPlayers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeAdminClient) GetGame(ctx context.Context, in *GetGameRequest, opts ...grpc.CallOption) (*AdminGame, error) {
+	out := new(AdminGame)
+	err := c.cc.Invoke(ctx, "/server2.TicTacToeAdmin/GetGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeAdminClient) GetLobby(ctx context.Context, in *GetLobbyRequest, opts ...grpc.CallOption) (*AdminLobby, error) {
+	out := new(AdminLobby)
+	err := c.cc.Invoke(ctx, "/server2.TicTacToeAdmin/GetLobby", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeAdminClient) EndGame(ctx context.Context, in *EndGameRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/server2.TicTacToeAdmin/EndGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeAdminClient) CloseLobby(ctx context.Context, in *CloseLobbyRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/server2.TicTacToeAdmin/CloseLobby", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeAdminClient) KickPlayer(ctx context.Context, in *KickPlayerRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/server2.TicTacToeAdmin/KickPlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeAdminClient) BanPlayer(ctx context.Context, in *BanPlayerRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/server2.TicTacToeAdmin/BanPlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeAdminClient) UnbanPlayer(ctx context.Context, in *UnbanPlayerRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/server2.TicTacToeAdmin/UnbanPlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeAdminClient) GetPlayerBans(ctx context.Context, in *GetPlayerBansRequest, opts ...grpc.CallOption) (*GetPlayerBansReply, error) {
+	out := new(GetPlayerBansReply)
+	err := c.cc.Invoke(ctx, "/server2.TicTacToeAdmin/GetPlayerBans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeAdminClient) BroadcastMessage(ctx context.Context, in *BroadcastMessageRequest, opts ...grpc.CallOption) (*BroadcastMessageReply, error) {
+	out := new(BroadcastMessageReply)
+	err := c.cc.Invoke(ctx, "/server2.TicTacToeAdmin/BroadcastMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeAdminClient) CreateAnnouncement(ctx context.Context, in *CreateAnnouncementRequest, opts ...grpc.CallOption) (*Announcement, error) {
+	out := new(Announcement)
+	err := c.cc.Invoke(ctx, "/server2.TicTacToeAdmin/CreateAnnouncement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeAdminClient) ListAnnouncements(ctx context.Context, in *ListAnnouncementsRequest, opts ...grpc.CallOption) (*ListAnnouncementsReply, error) {
+	out := new(ListAnnouncementsReply)
+	err := c.cc.Invoke(ctx, "/server2.TicTacToeAdmin/ListAnnouncements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeAdminClient) DeleteAnnouncement(ctx context.Context, in *DeleteAnnouncementRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/server2.TicTacToeAdmin/DeleteAnnouncement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeAdminClient) SetMaintenanceMode(ctx context.Context, in *SetMaintenanceModeRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/server2.TicTacToeAdmin/SetMaintenanceMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TicTacToeAdminServer is the server API for TicTacToeAdmin service.
+// All implementations must embed UnimplementedTicTacToeAdminServer
+// for forward compatibility
+type TicTacToeAdminServer interface {
+	ListClients(context.Context, *ListClientsRequest) (*ListClientsReply, error)
+	ListPlayers(context.Context, *ListPlayersRequest) (*ListPlayersReply, error)
+	GetGame(context.Context, *GetGameRequest) (*AdminGame, error)
+	GetLobby(context.Context, *GetLobbyRequest) (*AdminLobby, error)
+	EndGame(context.Context, *EndGameRequest) (*Empty, error)
+	CloseLobby(context.Context, *CloseLobbyRequest) (*Empty, error)
+	KickPlayer(context.Context, *KickPlayerRequest) (*Empty, error)
+	BanPlayer(context.Context, *BanPlayerRequest) (*Empty, error)
+	UnbanPlayer(context.Context, *UnbanPlayerRequest) (*Empty, error)
+	GetPlayerBans(context.Context, *GetPlayerBansRequest) (*GetPlayerBansReply, error)
+	BroadcastMessage(context.Context, *BroadcastMessageRequest) (*BroadcastMessageReply, error)
+	CreateAnnouncement(context.Context, *CreateAnnouncementRequest) (*Announcement, error)
+	ListAnnouncements(context.Context, *ListAnnouncementsRequest) (*ListAnnouncementsReply, error)
+	DeleteAnnouncement(context.Context, *DeleteAnnouncementRequest) (*Empty, error)
+	SetMaintenanceMode(context.Context, *SetMaintenanceModeRequest) (*Empty, error)
+	mustEmbedUnimplementedTicTacToeAdminServer()
+}
+
+// UnimplementedTicTacToeAdminServer must be embedded to have forward compatible implementations.
+type UnimplementedTicTacToeAdminServer struct {
+}
+
+func (UnimplementedTicTacToeAdminServer) ListClients(context.Context, *ListClientsRequest) (*ListClientsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClients not implemented")
+}
+func (UnimplementedTicTacToeAdminServer) ListPlayers(context.Context, *ListPlayersRequest) (*ListPlayersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlayers not implemented")
+}
+func (UnimplementedTicTacToeAdminServer) GetGame(context.Context, *GetGameRequest) (*AdminGame, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGame not implemented")
+}
+func (UnimplementedTicTacToeAdminServer) GetLobby(context.Context, *GetLobbyRequest) (*AdminLobby, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLobby not implemented")
+}
+func (UnimplementedTicTacToeAdminServer) EndGame(context.Context, *EndGameRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndGame not implemented")
+}
+func (UnimplementedTicTacToeAdminServer) CloseLobby(context.Context, *CloseLobbyRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseLobby not implemented")
+}
+func (UnimplementedTicTacToeAdminServer) KickPlayer(context.Context, *KickPlayerRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickPlayer not implemented")
+}
+func (UnimplementedTicTacToeAdminServer) BanPlayer(context.Context, *BanPlayerRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanPlayer not implemented")
+}
+func (UnimplementedTicTacToeAdminServer) UnbanPlayer(context.Context, *UnbanPlayerRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbanPlayer not implemented")
+}
+func (UnimplementedTicTacToeAdminServer) GetPlayerBans(context.Context, *GetPlayerBansRequest) (*GetPlayerBansReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerBans not implemented")
+}
+func (UnimplementedTicTacToeAdminServer) BroadcastMessage(context.Context, *BroadcastMessageRequest) (*BroadcastMessageReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BroadcastMessage not implemented")
+}
+func (UnimplementedTicTacToeAdminServer) CreateAnnouncement(context.Context, *CreateAnnouncementRequest) (*Announcement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAnnouncement not implemented")
+}
+func (UnimplementedTicTacToeAdminServer) ListAnnouncements(context.Context, *ListAnnouncementsRequest) (*ListAnnouncementsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAnnouncements not implemented")
+}
+func (UnimplementedTicTacToeAdminServer) DeleteAnnouncement(context.Context, *DeleteAnnouncementRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAnnouncement not implemented")
+}
+func (UnimplementedTicTacToeAdminServer) SetMaintenanceMode(context.Context, *SetMaintenanceModeRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaintenanceMode not implemented")
+}
+func (UnimplementedTicTacToeAdminServer) mustEmbedUnimplementedTicTacToeAdminServer() {}
+
+// UnsafeTicTacToeAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TicTacToeAdminServer will
+// result in compilation errors.
+type UnsafeTicTacToeAdminServer interface {
+	mustEmbedUnimplementedTicTacToeAdminServer()
+}
+
+func RegisterTicTacToeAdminServer(s grpc.ServiceRegistrar, srv TicTacToeAdminServer) {
+	s.RegisterService(&TicTacToeAdmin_ServiceDesc, srv)
+}
+
+func _TicTacToeAdmin_ListClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeAdminServer).ListClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server2.TicTacToeAdmin/ListClients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeAdminServer).ListClients(ctx, req.(*ListClientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeAdmin_ListPlayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlayersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeAdminServer).ListPlayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server2.TicTacToeAdmin/ListPlayers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeAdminServer).ListPlayers(ctx, req.(*ListPlayersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeAdmin_GetGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeAdminServer).GetGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server2.TicTacToeAdmin/GetGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeAdminServer).GetGame(ctx, req.(*GetGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeAdmin_GetLobby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLobbyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeAdminServer).GetLobby(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server2.TicTacToeAdmin/GetLobby",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeAdminServer).GetLobby(ctx, req.(*GetLobbyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeAdmin_EndGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeAdminServer).EndGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server2.TicTacToeAdmin/EndGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeAdminServer).EndGame(ctx, req.(*EndGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeAdmin_CloseLobby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseLobbyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeAdminServer).CloseLobby(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server2.TicTacToeAdmin/CloseLobby",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeAdminServer).CloseLobby(ctx, req.(*CloseLobbyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeAdmin_KickPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeAdminServer).KickPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server2.TicTacToeAdmin/KickPlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeAdminServer).KickPlayer(ctx, req.(*KickPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeAdmin_BanPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeAdminServer).BanPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server2.TicTacToeAdmin/BanPlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeAdminServer).BanPlayer(ctx, req.(*BanPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeAdmin_UnbanPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeAdminServer).UnbanPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server2.TicTacToeAdmin/UnbanPlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeAdminServer).UnbanPlayer(ctx, req.(*UnbanPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeAdmin_GetPlayerBans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerBansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeAdminServer).GetPlayerBans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server2.TicTacToeAdmin/GetPlayerBans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeAdminServer).GetPlayerBans(ctx, req.(*GetPlayerBansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeAdmin_BroadcastMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BroadcastMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeAdminServer).BroadcastMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server2.TicTacToeAdmin/BroadcastMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeAdminServer).BroadcastMessage(ctx, req.(*BroadcastMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeAdmin_CreateAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAnnouncementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeAdminServer).CreateAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server2.TicTacToeAdmin/CreateAnnouncement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeAdminServer).CreateAnnouncement(ctx, req.(*CreateAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeAdmin_ListAnnouncements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAnnouncementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeAdminServer).ListAnnouncements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server2.TicTacToeAdmin/ListAnnouncements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeAdminServer).ListAnnouncements(ctx, req.(*ListAnnouncementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeAdmin_DeleteAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAnnouncementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeAdminServer).DeleteAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server2.TicTacToeAdmin/DeleteAnnouncement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeAdminServer).DeleteAnnouncement(ctx, req.(*DeleteAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeAdmin_SetMaintenanceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMaintenanceModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeAdminServer).SetMaintenanceMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server2.TicTacToeAdmin/SetMaintenanceMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeAdminServer).SetMaintenanceMode(ctx, req.(*SetMaintenanceModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TicTacToeAdmin_ServiceDesc is the grpc.ServiceDesc for TicTacToeAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TicTacToeAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "server2.TicTacToeAdmin",
+	HandlerType: (*TicTacToeAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListClients",
+			Handler:    _TicTacToeAdmin_ListClients_Handler,
+		},
+		{
+			MethodName: "ListPlayers",
+			Handler:    _TicTacToeAdmin_ListPlayers_Handler,
+		},
+		{
+			MethodName: "GetGame",
+			Handler:    _TicTacToeAdmin_GetGame_Handler,
+		},
+		{
+			MethodName: "GetLobby",
+			Handler:    _TicTacToeAdmin_GetLobby_Handler,
+		},
+		{
+			MethodName: "EndGame",
+			Handler:    _TicTacToeAdmin_EndGame_Handler,
+		},
+		{
+			MethodName: "CloseLobby",
+			Handler:    _TicTacToeAdmin_CloseLobby_Handler,
+		},
+		{
+			MethodName: "KickPlayer",
+			Handler:    _TicTacToeAdmin_KickPlayer_Handler,
+		},
+		{
+			MethodName: "BanPlayer",
+			Handler:    _TicTacToeAdmin_BanPlayer_Handler,
+		},
+		{
+			MethodName: "UnbanPlayer",
+			Handler:    _TicTacToeAdmin_UnbanPlayer_Handler,
+		},
+		{
+			MethodName: "GetPlayerBans",
+			Handler:    _TicTacToeAdmin_GetPlayerBans_Handler,
+		},
+		{
+			MethodName: "BroadcastMessage",
+			Handler:    _TicTacToeAdmin_BroadcastMessage_Handler,
+		},
+		{
+			MethodName: "CreateAnnouncement",
+			Handler:    _TicTacToeAdmin_CreateAnnouncement_Handler,
+		},
+		{
+			MethodName: "ListAnnouncements",
+			Handler:    _TicTacToeAdmin_ListAnnouncements_Handler,
+		},
+		{
+			MethodName: "DeleteAnnouncement",
+			Handler:    _TicTacToeAdmin_DeleteAnnouncement_Handler,
+		},
+		{
+			MethodName: "SetMaintenanceMode",
+			Handler:    _TicTacToeAdmin_SetMaintenanceMode_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "server2/tctxto2.proto",
+}
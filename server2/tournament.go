@@ -0,0 +1,413 @@
+package server2
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"time"
+	"txtcto/models"
+
+	"github.com/google/uuid"
+)
+
+const tournamentMatchDelay = 3 * time.Second
+
+func (s *Server) getTournamentMatch(game *models.Game) (*models.Tournament, *models.TournamentMatch, bool) {
+	if game.TournamentId == "" {
+		return nil, nil, false
+	}
+
+	tournament, exists := s.tournaments.get(game.TournamentId)
+	if !exists || tournament.Status != models.TournamentStatus_IN_PROGRESS {
+		return nil, nil, false
+	}
+
+	match, exists := tournament.GetMatchByGame(game.Id)
+	if !exists || match.Finished {
+		return nil, nil, false
+	}
+
+	return tournament, match, true
+}
+
+func (s *Server) startTournament(ctx context.Context, tournamentId string) {
+	s.tournamentsMu.Lock()
+	defer s.tournamentsMu.Unlock()
+
+	tournament, exists := s.tournaments.get(tournamentId)
+	if !exists || tournament.Status != models.TournamentStatus_REGISTRATION {
+		return
+	}
+
+	if len(tournament.Entrants) < 2 {
+		tournament.Status = models.TournamentStatus_CANCELLED
+		tournament.EndedAt = time.Now()
+		s.queueTournamentBracketUpdate(ctx, tournament)
+		return
+	}
+
+	if outcome := s.checkMaintenance(); !outcome.Ok {
+		time.AfterFunc(tournamentMatchDelay, func() {
+			s.startTournament(context.Background(), tournamentId)
+		})
+		return
+	}
+
+	// Ratings are not updated after games yet, so every player still has
+	// defaultRating and the stable sort leaves entrants seeded in the order
+	// they joined.
+	sort.SliceStable(tournament.Entrants, func(i, j int) bool {
+		return tournament.Entrants[i].Player.Rating > tournament.Entrants[j].Player.Rating
+	})
+	for i, entrant := range tournament.Entrants {
+		entrant.Seed = int32(i + 1)
+	}
+
+	tournament.Status = models.TournamentStatus_IN_PROGRESS
+
+	if tournament.Format == models.TournamentFormat_ROUND_ROBIN {
+		tournament.Matches = s.scheduleRoundRobin(tournament)
+	}
+
+	s.startTournamentRound(ctx, tournament, 1)
+}
+
+// scheduleRoundRobin pairs every entrant with every other entrant once using
+// the circle method. With an odd number of entrants one of them sits out each
+// round.
+func (s *Server) scheduleRoundRobin(tournament *models.Tournament) []*models.TournamentMatch {
+	players := make([]*models.Player, 0, len(tournament.Entrants)+1)
+	for _, entrant := range tournament.Entrants {
+		players = append(players, entrant.Player)
+	}
+	if len(players)%2 == 1 {
+		players = append(players, nil)
+	}
+
+	n := len(players)
+	matches := []*models.TournamentMatch{}
+	for round := 1; round < n; round++ {
+		for i := 0; i < n/2; i++ {
+			player1, player2 := players[i], players[n-1-i]
+			if player1 == nil || player2 == nil {
+				continue
+			}
+			matches = append(matches, &models.TournamentMatch{
+				Id:      uuid.New().String(),
+				Round:   int32(round),
+				Player1: player1,
+				Player2: player2,
+			})
+		}
+		players = append([]*models.Player{players[0], players[n-1]}, players[1:n-1]...)
+	}
+
+	return matches
+}
+
+// scheduleEliminationRound pairs the entrants that are still alive, grouped by
+// how many losses they have. In double elimination the last undefeated entrant
+// and the last entrant with one loss meet in the grand final, which is played
+// again if the undefeated entrant loses it.
+func (s *Server) scheduleEliminationRound(tournament *models.Tournament, round int32) []*models.TournamentMatch {
+	maxLosses := int32(1)
+	if tournament.Format == models.TournamentFormat_DOUBLE_ELIMINATION {
+		maxLosses = 2
+	}
+
+	groups := make([][]*models.TournamentEntrant, maxLosses)
+	alive := 0
+	for _, entrant := range tournament.Entrants {
+		if entrant.Losses < maxLosses {
+			groups[entrant.Losses] = append(groups[entrant.Losses], entrant)
+			alive++
+		}
+	}
+
+	if alive < 2 {
+		return nil
+	}
+
+	if maxLosses == 2 && len(groups[0]) == 1 && len(groups[1]) == 1 {
+		return []*models.TournamentMatch{s.createTournamentMatch(round, groups[0][0].Player, groups[1][0].Player)}
+	}
+
+	matches := []*models.TournamentMatch{}
+	for _, group := range groups {
+		if len(group)%2 == 1 {
+			bye := s.pickTournamentBye(tournament, group)
+			matches = append(matches, s.createTournamentMatch(round, group[bye].Player, nil))
+			group = slices.Delete(slices.Clone(group), bye, bye+1)
+		}
+		for i := 0; i < len(group)/2; i++ {
+			matches = append(matches, s.createTournamentMatch(round, group[i].Player, group[len(group)-1-i].Player))
+		}
+	}
+
+	return matches
+}
+
+// pickTournamentBye returns the index of the entrant in the group who sits
+// out the round: the one with the fewest byes so far, the higher seed first.
+func (s *Server) pickTournamentBye(tournament *models.Tournament, group []*models.TournamentEntrant) int {
+	byes := map[string]int{}
+	for _, match := range tournament.Matches {
+		if match.Player2 == nil {
+			byes[match.Player1.Id]++
+		}
+	}
+
+	bye := 0
+	for i, entrant := range group {
+		if byes[entrant.Player.Id] < byes[group[bye].Player.Id] {
+			bye = i
+		}
+	}
+	return bye
+}
+
+func (s *Server) createTournamentMatch(round int32, player1, player2 *models.Player) *models.TournamentMatch {
+	match := &models.TournamentMatch{
+		Id:      uuid.New().String(),
+		Round:   round,
+		Player1: player1,
+		Player2: player2,
+	}
+	if player2 == nil {
+		match.Winner = player1
+		match.Finished = true
+	}
+	return match
+}
+
+func (s *Server) startTournamentRound(ctx context.Context, tournament *models.Tournament, round int32) {
+	if tournament.Format != models.TournamentFormat_ROUND_ROBIN {
+		tournament.Matches = append(tournament.Matches, s.scheduleEliminationRound(tournament, round)...)
+	}
+
+	started := false
+	for _, match := range tournament.Matches {
+		if match.Round != round {
+			continue
+		}
+		started = true
+		if !match.Finished {
+			s.startTournamentMatch(ctx, tournament, match)
+		}
+	}
+
+	if !started {
+		tournament.Status = models.TournamentStatus_FINISHED
+		tournament.EndedAt = time.Now()
+		s.queueTournamentBracketUpdate(ctx, tournament)
+		return
+	}
+
+	tournament.Round = round
+	s.queueTournamentBracketUpdate(ctx, tournament)
+
+	if tournament.RoundFinished(round) {
+		s.startTournamentRound(ctx, tournament, round+1)
+	}
+}
+
+// startTournamentMatch creates the game for a match. A player who is offline
+// or still busy in another game forfeits the match; if both are unavailable
+// the higher seed advances.
+func (s *Server) startTournamentMatch(ctx context.Context, tournament *models.Tournament, match *models.TournamentMatch) {
+	player1ClientId, player1Available := s.getAvailableTournamentClientId(tournament, match.Player1)
+	player2ClientId, player2Available := s.getAvailableTournamentClientId(tournament, match.Player2)
+
+	if !player1Available || !player2Available {
+		s.observeTournamentWalkover()
+		if player1Available || !player2Available {
+			s.recordTournamentMatch(tournament, match, match.Player1)
+		} else {
+			s.recordTournamentMatch(tournament, match, match.Player2)
+		}
+		return
+	}
+
+	game, outcome := s.setupGame(ctx, tournament.Creator, match.Player1, match.Player2)
+	if !outcome.Ok {
+		s.observeTournamentWalkover()
+		s.recordTournamentMatch(tournament, match, match.Player1)
+		return
+	}
+
+	game.TournamentId = tournament.Id
+	match.GameId = game.Id
+
+	s.queueServerUpdatesAndSignal(ctx, player1ClientId,
+		s.createNavigationUpdate(NavigationPath_GAME),
+		s.createGameStartUpdate(game, match.Player1),
+		s.createNextMoverUpdate(s.areYouTheMover(game, match.Player1)),
+	)
+	s.queueServerUpdatesAndSignal(ctx, player2ClientId,
+		s.createNavigationUpdate(NavigationPath_GAME),
+		s.createGameStartUpdate(game, match.Player2),
+		s.createNextMoverUpdate(s.areYouTheMover(game, match.Player2)),
+	)
+}
+
+// getAvailableTournamentClientId returns the client of a player who can start
+// a match. A player whose finished match of this tournament has not been
+// released yet by finishTournamentMatch is available: the other matches of a
+// round end at different times, and the first one to be released starts the
+// next round.
+func (s *Server) getAvailableTournamentClientId(tournament *models.Tournament, player *models.Player) (string, bool) {
+	if gameId, exists := s.playerGame.get(player.Id); exists {
+		if match, exists := tournament.GetMatchByGame(gameId); !exists || !match.Finished {
+			return "", false
+		}
+		s.playerGame.delete(player.Id)
+	}
+	return s.playerClient.get(player.Id)
+}
+
+func (s *Server) recordTournamentMatch(tournament *models.Tournament, match *models.TournamentMatch, winner *models.Player) {
+	match.Winner = winner
+	match.Finished = true
+
+	for _, player := range []*models.Player{match.Player1, match.Player2} {
+		entrant, exists := tournament.GetEntrant(player.Id)
+		if !exists {
+			continue
+		}
+		switch {
+		case winner == nil:
+			entrant.Draws++
+		case winner.Id == player.Id:
+			entrant.Wins++
+		default:
+			entrant.Losses++
+			entrant.EliminatedRound = match.Round
+		}
+	}
+}
+
+// recordTournamentResult is called once a tournament game has ended. Drawn
+// elimination matches are replayed; otherwise the next round starts as soon
+// as every match of the current round is finished.
+func (s *Server) recordTournamentResult(ctx context.Context, tournament *models.Tournament, match *models.TournamentMatch, game *models.Game) {
+	replay := game.Winner == nil && tournament.Format != models.TournamentFormat_ROUND_ROBIN
+	if !replay {
+		s.tournamentsMu.Lock()
+		s.recordTournamentMatch(tournament, match, game.Winner)
+		s.tournamentsMu.Unlock()
+	}
+
+	s.queueTournamentBracketUpdate(ctx, tournament)
+
+	time.AfterFunc(tournamentMatchDelay, func() {
+		s.finishTournamentMatch(context.Background(), tournament, match, game, replay)
+	})
+}
+
+// finishTournamentMatch takes the players out of an ended tournament game,
+// moves the tournament on and sends the players who are not in a new game
+// back to where they were.
+func (s *Server) finishTournamentMatch(ctx context.Context, tournament *models.Tournament, match *models.TournamentMatch, game *models.Game, replay bool) {
+	s.tournamentsMu.Lock()
+	defer s.tournamentsMu.Unlock()
+
+	for _, player := range []*models.Player{match.Player1, match.Player2} {
+		if gameId, exists := s.playerGame.get(player.Id); exists && gameId == game.Id {
+			s.playerGame.delete(player.Id)
+		}
+	}
+	s.refreshPresence(ctx, match.Player1.Id, match.Player2.Id)
+
+	s.advanceTournament(ctx, tournament, match, replay)
+
+	for _, player := range []*models.Player{match.Player1, match.Player2} {
+		if _, exists := s.playerGame.get(player.Id); exists {
+			continue
+		}
+		if clientId, exists := s.playerClient.get(player.Id); exists {
+			s.queueServerUpdatesAndSignal(ctx, clientId, s.initialServerUpdates(ctx, clientId)...)
+		}
+	}
+}
+
+// advanceTournament replays a drawn match, or starts the next round once every
+// match of the current one is finished. No games start during maintenance, so
+// it tries again after tournamentMatchDelay until maintenance is over. The
+// caller holds tournamentsMu.
+func (s *Server) advanceTournament(ctx context.Context, tournament *models.Tournament, match *models.TournamentMatch, replay bool) {
+	if tournament.Status != models.TournamentStatus_IN_PROGRESS {
+		return
+	}
+
+	if outcome := s.checkMaintenance(); !outcome.Ok {
+		time.AfterFunc(tournamentMatchDelay, func() {
+			s.tournamentsMu.Lock()
+			defer s.tournamentsMu.Unlock()
+
+			s.advanceTournament(context.Background(), tournament, match, replay)
+		})
+		return
+	}
+
+	if replay {
+		s.startTournamentMatch(ctx, tournament, match)
+		s.queueTournamentBracketUpdate(ctx, tournament)
+	} else if tournament.Round == match.Round && tournament.RoundFinished(match.Round) {
+		s.startTournamentRound(ctx, tournament, match.Round+1)
+	}
+}
+
+func (s *Server) getTournamentStandings(tournament *models.Tournament) []*models.TournamentEntrant {
+	standings := append([]*models.TournamentEntrant{}, tournament.Entrants...)
+
+	if tournament.Format == models.TournamentFormat_ROUND_ROBIN {
+		sort.SliceStable(standings, func(i, j int) bool {
+			if standings[i].Points() != standings[j].Points() {
+				return standings[i].Points() > standings[j].Points()
+			}
+			if standings[i].Wins != standings[j].Wins {
+				return standings[i].Wins > standings[j].Wins
+			}
+			return standings[i].Seed < standings[j].Seed
+		})
+		return standings
+	}
+
+	maxLosses := int32(1)
+	if tournament.Format == models.TournamentFormat_DOUBLE_ELIMINATION {
+		maxLosses = 2
+	}
+
+	sort.SliceStable(standings, func(i, j int) bool {
+		iAlive, jAlive := standings[i].Losses < maxLosses, standings[j].Losses < maxLosses
+		if iAlive != jAlive {
+			return iAlive
+		}
+		if !iAlive && standings[i].EliminatedRound != standings[j].EliminatedRound {
+			return standings[i].EliminatedRound > standings[j].EliminatedRound
+		}
+		if standings[i].Losses != standings[j].Losses {
+			return standings[i].Losses < standings[j].Losses
+		}
+		return standings[i].Seed < standings[j].Seed
+	})
+	return standings
+}
+
+func (s *Server) queueTournamentBracketUpdate(ctx context.Context, tournament *models.Tournament) {
+	update := s.createTournamentBracketUpdate(tournament)
+
+	recipients := map[string]bool{}
+	for _, entrant := range tournament.Entrants {
+		recipients[entrant.Player.Id] = true
+	}
+	for playerId := range tournament.Spectators {
+		recipients[playerId] = true
+	}
+
+	for playerId := range recipients {
+		if clientId, exists := s.playerClient.get(playerId); exists {
+			s.queueServerUpdatesAndSignal(ctx, clientId, update)
+		}
+	}
+}
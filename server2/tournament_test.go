@@ -0,0 +1,68 @@
+package server2
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"txtcto/models"
+)
+
+func TestNextRoundStartsBeforeEveryMatchIsReleased(t *testing.T) {
+	s := newJanitorTestServer()
+	ctx := context.Background()
+
+	tournament := &models.Tournament{
+		Id:         "tournament",
+		Format:     models.TournamentFormat_SINGLE_ELIMINATION,
+		Status:     models.TournamentStatus_REGISTRATION,
+		Spectators: map[string]bool{},
+	}
+	for i := 0; i < 4; i++ {
+		player := signUpJanitorTestPlayer(t, s, fmt.Sprintf("c%d", i))
+		tournament.Entrants = append(tournament.Entrants, &models.TournamentEntrant{Player: player})
+	}
+	tournament.Creator = tournament.Entrants[0].Player
+	s.tournaments.set(tournament.Id, tournament)
+
+	s.startTournament(ctx, tournament.Id)
+
+	if len(tournament.Matches) != 2 {
+		t.Fatalf("round 1 has %d matches, want 2", len(tournament.Matches))
+	}
+	first, second := tournament.Matches[0], tournament.Matches[1]
+	games := map[*models.TournamentMatch]*models.Game{}
+	for _, match := range []*models.TournamentMatch{first, second} {
+		game, exists := s.games.get(match.GameId)
+		if !exists {
+			t.Fatalf("match %s has no game", match.Id)
+		}
+		game.Result = models.GameResult_WIN
+		game.Winner = match.Player1
+		games[match] = game
+	}
+
+	// Both matches end, a second apart, so the first one is released while
+	// the players of the second one are still waiting for theirs.
+	for _, match := range []*models.TournamentMatch{first, second} {
+		s.tournamentsMu.Lock()
+		s.recordTournamentMatch(tournament, match, games[match].Winner)
+		s.tournamentsMu.Unlock()
+	}
+	s.finishTournamentMatch(ctx, tournament, first, games[first], false)
+
+	if tournament.Round != 2 || len(tournament.Matches) != 3 {
+		t.Fatalf("tournament is in round %d with %d matches, want round 2 with 3", tournament.Round, len(tournament.Matches))
+	}
+	final := tournament.Matches[2]
+	if final.Finished || final.GameId == "" {
+		t.Fatal("the final was decided by walkover")
+	}
+
+	s.finishTournamentMatch(ctx, tournament, second, games[second], false)
+
+	for _, player := range []*models.Player{final.Player1, final.Player2} {
+		if gameId, _ := s.playerGame.get(player.Id); gameId != final.GameId {
+			t.Errorf("player %s is in game %q, want the final %q", player.Id, gameId, final.GameId)
+		}
+	}
+}
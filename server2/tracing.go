@@ -0,0 +1,99 @@
+package server2
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+const tracerName = "txtcto/server2"
+
+// metadataCarrier adapts incoming gRPC metadata for trace context extraction.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	if values := metadata.MD(c).Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// startClientUpdateSpan continues the trace sent in the request metadata, if
+// any, with a span covering the handling of one client update.
+func (s *Server) startClientUpdateSpan(ctx context.Context, clientId, correlationId string, update *ClientUpdate) (context.Context, trace.Span) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+	}
+
+	return s.tracer.Start(ctx, "ClientUpdate/"+clientUpdateTypeName(update),
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("tctxto.client_id", clientId),
+			attribute.String("tctxto.correlation_id", correlationId),
+		),
+	)
+}
+
+func endClientUpdateSpan(span trace.Span, request *clientRequest, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	} else if outcome := request.outcome; outcome != nil && !outcome.Ok {
+		span.SetStatus(codes.Error, outcome.ErrorMessage)
+	}
+	span.End()
+}
+
+// traceQueuedUpdates records the queueing of updates for a client and
+// returns the span's context, which the queue keeps next to each update so
+// the span that later sends the update joins the same trace.
+func (s *Server) traceQueuedUpdates(ctx context.Context, clientId string, updates []*ServerUpdate) trace.SpanContext {
+	_, span := s.tracer.Start(ctx, "queueServerUpdates",
+		trace.WithAttributes(
+			attribute.String("tctxto.client_id", clientId),
+			attribute.Int("tctxto.updates", len(updates)),
+		),
+	)
+	defer span.End()
+
+	return span.SpanContext()
+}
+
+// startSendSpan starts the span for sending one update on a stream, as a
+// child of the span that queued it.
+func (s *Server) startSendSpan(clientId string, queued queuedUpdate) trace.Span {
+	ctx := trace.ContextWithSpanContext(context.Background(), queued.spanContext)
+
+	_, span := s.tracer.Start(ctx, "sendServerUpdate",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			attribute.String("tctxto.client_id", clientId),
+			attribute.String("tctxto.update_type", serverUpdateTypeName(queued.update)),
+		),
+	)
+	return span
+}
+
+func serverUpdateTypeName(update *ServerUpdate) string {
+	field := update.ProtoReflect().WhichOneof(update.ProtoReflect().Descriptor().Oneofs().ByName("type"))
+	if field == nil {
+		return "Unknown"
+	}
+	return string(field.Message().Name())
+}
@@ -0,0 +1,33 @@
+package server2
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+)
+
+func (s *Server) unblockPlayer(ctx context.Context, clientId string, in *UnblockPlayerRequest) error {
+	player, outcome := s.validatePlayer(ctx, clientId)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createUnblockPlayerReply(outcome))
+		return nil
+	}
+
+	if !player.Blocked[in.PlayerId] {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createUnblockPlayerReply(&Outcome{
+			Ok:           false,
+			ErrorCode:    int32(codes.NotFound),
+			ErrorMessage: "player is not blocked",
+		}))
+		return nil
+	}
+
+	player.Unblock(in.PlayerId)
+
+	s.queueServerUpdatesAndSignal(ctx, clientId,
+		s.createUnblockPlayerReply(&Outcome{Ok: true}),
+		s.createBlockListUpdate(player),
+	)
+
+	return nil
+}
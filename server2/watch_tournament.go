@@ -0,0 +1,35 @@
+package server2
+
+import "context"
+
+func (s *Server) watchTournament(ctx context.Context, clientId string, in *WatchTournamentRequest) error {
+	player, outcome := s.validatePlayer(ctx, clientId)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createWatchTournamentReply(outcome))
+		return nil
+	}
+
+	s.tournamentsMu.Lock()
+	defer s.tournamentsMu.Unlock()
+
+	tournament, outcome := s.getTournament(in.TournamentId)
+	if !outcome.Ok {
+		s.queueServerUpdatesAndSignal(ctx, clientId, s.createWatchTournamentReply(outcome))
+		return nil
+	}
+
+	if in.Watch {
+		tournament.Spectators[player.Id] = true
+	} else {
+		delete(tournament.Spectators, player.Id)
+	}
+
+	updates := []*ServerUpdate{s.createWatchTournamentReply(&Outcome{Ok: true})}
+	if in.Watch {
+		updates = append(updates, s.createTournamentBracketUpdate(tournament))
+	}
+
+	s.queueServerUpdatesAndSignal(ctx, clientId, updates...)
+
+	return nil
+}
@@ -0,0 +1,208 @@
+package server2
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	status "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// webSocketMethod is the method name WebSocket subscriptions are logged
+	// and counted under.
+	webSocketMethod = "/server2.TicTacToe/WebSocket"
+
+	webSocketAuthTimeout  = 10 * time.Second
+	webSocketWriteTimeout = 10 * time.Second
+	webSocketReadLimit    = 64 * 1024
+)
+
+// WebSocketHandler serves SubscribeBiDir over WebSocket so browsers can play
+// against gRPC clients. The public key and client id are taken from the
+// public_key and client_id query parameters or, when public_key is missing,
+// from a WebSocketAuth first message. Messages are ClientUpdate and
+// ServerUpdate encoded as protobuf JSON in text frames, or as binary protobuf
+// in binary frames when the encoding query parameter is "binary".
+//
+// Browsers connecting from another origin are refused unless the origin is
+// in allowedOrigins; "*" allows any origin.
+func (s *Server) WebSocketHandler(allowedOrigins []string) http.Handler {
+	upgrader := websocket.Upgrader{}
+	if len(allowedOrigins) > 0 {
+		upgrader.CheckOrigin = func(r *http.Request) bool {
+			origin := r.Header.Get("Origin")
+			return origin == "" || slices.Contains(allowedOrigins, "*") || slices.Contains(allowedOrigins, origin)
+		}
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		binary := false
+		switch query.Get("encoding") {
+		case "", "json":
+		case "binary":
+			binary = true
+		default:
+			http.Error(w, "encoding must be json or binary", http.StatusBadRequest)
+			return
+		}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			// The upgrader has already replied with the error.
+			return
+		}
+		defer conn.Close()
+		conn.SetReadLimit(webSocketReadLimit)
+
+		md := metadata.MD{}
+		if correlationId := query.Get("correlation_id"); correlationId != "" {
+			md.Set("CorrelationId", correlationId)
+		}
+		if publicKey := query.Get("public_key"); publicKey != "" {
+			md.Set("PublicKey", publicKey)
+			md.Set("ClientId", query.Get("client_id"))
+		} else {
+			auth := &WebSocketAuth{}
+			if err := readWebSocketAuth(conn, auth); err != nil {
+				closeWebSocket(conn, websocket.ClosePolicyViolation, "expected a WebSocketAuth message")
+				return
+			}
+			md.Set("PublicKey", auth.PublicKey)
+			md.Set("ClientId", auth.ClientId)
+		}
+
+		ctx, cancel := context.WithCancel(metadata.NewIncomingContext(r.Context(), md))
+		defer cancel()
+		if r.TLS != nil {
+			ctx = peer.NewContext(ctx, &peer.Peer{
+				Addr:     conn.RemoteAddr(),
+				AuthInfo: credentials.TLSInfo{State: *r.TLS},
+			})
+		}
+
+		stream := &webSocketStream{conn: conn, ctx: ctx, cancel: cancel, binary: binary}
+		info := &grpc.StreamServerInfo{FullMethod: webSocketMethod, IsClientStream: true, IsServerStream: true}
+
+		err = s.StreamServerInterceptor()(s, stream, info, _TicTacToe_SubscribeBiDir_Handler)
+
+		closeCode := websocket.CloseNormalClosure
+		switch status.Code(err) {
+		case codes.OK, codes.Canceled:
+		case codes.Internal, codes.Unknown:
+			closeCode = websocket.CloseInternalServerErr
+		default:
+			closeCode = websocket.ClosePolicyViolation
+		}
+		closeWebSocket(conn, closeCode, status.Convert(err).Message())
+	})
+}
+
+func readWebSocketAuth(conn *websocket.Conn, auth *WebSocketAuth) error {
+	conn.SetReadDeadline(time.Now().Add(webSocketAuthTimeout))
+	defer conn.SetReadDeadline(time.Time{})
+
+	messageType, data, err := conn.ReadMessage()
+	if err != nil {
+		return err
+	}
+	return unmarshalWebSocketMessage(messageType, data, auth)
+}
+
+func closeWebSocket(conn *websocket.Conn, code int, reason string) {
+	// Control frames carry at most 125 bytes, two of which are the code.
+	if len(reason) > 123 {
+		reason = reason[:123]
+	}
+	conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(webSocketWriteTimeout))
+}
+
+func unmarshalWebSocketMessage(messageType int, data []byte, m proto.Message) error {
+	if messageType == websocket.BinaryMessage {
+		return proto.Unmarshal(data, m)
+	}
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, m)
+}
+
+// webSocketStream adapts a WebSocket connection to the server side of the
+// SubscribeBiDir stream.
+type webSocketStream struct {
+	conn   *websocket.Conn
+	ctx    context.Context
+	cancel context.CancelFunc
+	binary bool
+
+	writeMu sync.Mutex
+}
+
+func (ws *webSocketStream) Context() context.Context {
+	return ws.ctx
+}
+
+func (ws *webSocketStream) SendMsg(m any) error {
+	message, ok := m.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "unable to encode %T", m)
+	}
+
+	messageType := websocket.TextMessage
+	var data []byte
+	var err error
+	if ws.binary {
+		messageType = websocket.BinaryMessage
+		data, err = proto.Marshal(message)
+	} else {
+		data, err = protojson.Marshal(message)
+	}
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	ws.writeMu.Lock()
+	defer ws.writeMu.Unlock()
+
+	ws.conn.SetWriteDeadline(time.Now().Add(webSocketWriteTimeout))
+	if err := ws.conn.WriteMessage(messageType, data); err != nil {
+		ws.cancel()
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	return nil
+}
+
+// RecvMsg ends the subscription when the connection closes or sends a
+// message that does not decode, since SubscribeBiDir only watches the
+// context to notice a client has gone.
+func (ws *webSocketStream) RecvMsg(m any) error {
+	message, ok := m.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "unable to decode %T", m)
+	}
+
+	messageType, data, err := ws.conn.ReadMessage()
+	if err != nil {
+		ws.cancel()
+		return io.EOF
+	}
+	if err := unmarshalWebSocketMessage(messageType, data, message); err != nil {
+		ws.cancel()
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
+
+func (ws *webSocketStream) SetHeader(metadata.MD) error  { return nil }
+func (ws *webSocketStream) SendHeader(metadata.MD) error { return nil }
+func (ws *webSocketStream) SetTrailer(metadata.MD)       {}